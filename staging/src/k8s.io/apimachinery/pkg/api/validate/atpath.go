@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// AtPathSegmentKind identifies which field.Path accessor an AtPathSegment
+// corresponds to.
+type AtPathSegmentKind int
+
+const (
+	// AtPathField is a named struct field, applied via field.Path.Child.
+	AtPathField AtPathSegmentKind = iota
+	// AtPathIndex is a list index, applied via field.Path.Index.
+	AtPathIndex
+	// AtPathKey is a map or list-map key, applied via field.Path.Key.
+	AtPathKey
+)
+
+// AtPathSegment is one concrete step on the way to a value a generated
+// +k8s:atPath extractor selected -- a field name, a list index, or a map/
+// list-map key. A sequence of these plays back the same *field.Path calls
+// (.Child/.Index/.Key) the extractor's compile-time-resolved expression
+// represents, without the extractor itself needing to carry a *field.Path
+// through its walk.
+type AtPathSegment struct {
+	Kind  AtPathSegmentKind
+	Field string
+	Index int
+	Key   string
+}
+
+// AtPathElement pairs one value selected by a generated +k8s:atPath
+// extractor with the path segments that reach it -- a single atPath
+// expression can select many list/map elements at once (via a `[*]` step),
+// and each needs its own path for error reporting.
+type AtPathElement struct {
+	// Value holds a *TElem for the generated extractor's element type.
+	Value any
+	// Segments is the path from the field +k8s:atPath is declared on to
+	// Value, in order.
+	Segments []AtPathSegment
+}
+
+// buildAtPath applies segs to base, the same way a hand-written chain of
+// .Child/.Index/.Key calls would.
+func buildAtPath(base *field.Path, segs []AtPathSegment) *field.Path {
+	p := base
+	for _, s := range segs {
+		switch s.Kind {
+		case AtPathField:
+			p = p.Child(s.Field)
+		case AtPathIndex:
+			p = p.Index(s.Index)
+		case AtPathKey:
+			p = p.Key(s.Key)
+		}
+	}
+	return p
+}
+
+// formatAtPathSegments renders segs as a plain string, used only to match up
+// a new element with its old counterpart across an update -- not for
+// display (buildAtPath is what produces a reportable field.Path).
+func formatAtPathSegments(segs []AtPathSegment) string {
+	var b strings.Builder
+	for _, s := range segs {
+		switch s.Kind {
+		case AtPathField:
+			fmt.Fprintf(&b, ".%s", s.Field)
+		case AtPathIndex:
+			fmt.Fprintf(&b, "[%d]", s.Index)
+		case AtPathKey:
+			fmt.Fprintf(&b, "[%q]", s.Key)
+		}
+	}
+	return b.String()
+}
+
+// AtPathValues invokes validator once for every element a generated
+// +k8s:atPath extractor selects from newRoot, matching each one against its
+// counterpart (if any) extracted from oldRoot by identical path segments --
+// the same new/old pairing every other EachXxx helper in this package does,
+// generalized to atPath's multi-hop, possibly-multi-valued selection.
+func AtPathValues[TRoot, TElem any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newRoot, oldRoot *TRoot,
+	extract func(*TRoot) []AtPathElement,
+	validator ValidateFunc[*TElem],
+) field.ErrorList {
+	var oldElems []AtPathElement
+	if oldRoot != nil {
+		oldElems = extract(oldRoot)
+	}
+	oldByPath := make(map[string]*TElem, len(oldElems))
+	for _, e := range oldElems {
+		if v, ok := e.Value.(*TElem); ok {
+			oldByPath[formatAtPathSegments(e.Segments)] = v
+		}
+	}
+
+	var errs field.ErrorList
+	if newRoot == nil {
+		return errs
+	}
+	for _, e := range extract(newRoot) {
+		newVal, ok := e.Value.(*TElem)
+		if !ok {
+			continue
+		}
+		oldVal := oldByPath[formatAtPathSegments(e.Segments)]
+		errs = append(errs, validator(ctx, op, buildAtPath(fldPath, e.Segments), newVal, oldVal)...)
+	}
+	return errs
+}
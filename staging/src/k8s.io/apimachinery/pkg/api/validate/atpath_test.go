@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type atPathContainer struct {
+	Image string
+}
+
+type atPathRoot struct {
+	Containers []atPathContainer
+}
+
+func extractAtPathImages(r *atPathRoot) []AtPathElement {
+	var out []AtPathElement
+	for i := range r.Containers {
+		out = append(out, AtPathElement{
+			Value: &r.Containers[i].Image,
+			Segments: []AtPathSegment{
+				{Kind: AtPathField, Field: "containers"},
+				{Kind: AtPathIndex, Index: i},
+				{Kind: AtPathField, Field: "image"},
+			},
+		})
+	}
+	return out
+}
+
+func TestAtPathValues(t *testing.T) {
+	newRoot := &atPathRoot{Containers: []atPathContainer{{Image: ""}, {Image: "nginx"}}}
+
+	var gotPaths []string
+	validator := func(_ context.Context, _ operation.Operation, fldPath *field.Path, newVal, _ *string) field.ErrorList {
+		gotPaths = append(gotPaths, fldPath.String())
+		if *newVal == "" {
+			return field.ErrorList{field.Required(fldPath, "")}
+		}
+		return nil
+	}
+
+	errs := AtPathValues(context.Background(), operation.Operation{Type: operation.Create}, field.NewPath("spec"), newRoot, nil, extractAtPathImages, validator)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if want := "spec.containers[0].image"; errs[0].Field != want {
+		t.Errorf("expected error at %q, got %q", want, errs[0].Field)
+	}
+	if want := []string{"spec.containers[0].image", "spec.containers[1].image"}; !equalStringSlices(gotPaths, want) {
+		t.Errorf("expected validator called at paths %v, got %v", want, gotPaths)
+	}
+}
+
+func TestAtPathValuesMatchesOldByPath(t *testing.T) {
+	oldRoot := &atPathRoot{Containers: []atPathContainer{{Image: "nginx"}}}
+	newRoot := &atPathRoot{Containers: []atPathContainer{{Image: "nginx"}}}
+
+	var oldSeen []string
+	validator := func(_ context.Context, _ operation.Operation, _ *field.Path, _, oldVal *string) field.ErrorList {
+		if oldVal != nil {
+			oldSeen = append(oldSeen, *oldVal)
+		}
+		return nil
+	}
+
+	errs := AtPathValues(context.Background(), operation.Operation{Type: operation.Update}, field.NewPath("spec"), newRoot, oldRoot, extractAtPathImages, validator)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if len(oldSeen) != 1 || oldSeen[0] != "nginx" {
+		t.Errorf("expected old value %q to be matched by path, got %v", "nginx", oldSeen)
+	}
+}
+
+func TestAtPathValuesNilRoot(t *testing.T) {
+	validator := func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *string) field.ErrorList {
+		t.Fatalf("validator should not be called for a nil root")
+		return nil
+	}
+	errs := AtPathValues[atPathRoot](context.Background(), operation.Operation{Type: operation.Create}, field.NewPath("spec"), nil, nil, extractAtPathImages, validator)
+	if len(errs) != 0 {
+		t.Errorf("expected no errors for a nil root, got %s", fmtErrs(errs))
+	}
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
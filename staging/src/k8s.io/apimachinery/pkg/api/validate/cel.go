@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	celgo "github.com/google/cel-go/cel"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// celPerCallCostLimit bounds the cost of a single CompiledCEL evaluation,
+// the same safeguard (and the same order of magnitude) CRD CEL validation
+// applies to an x-kubernetes-validations rule: a k8s:cel predicate runs
+// against an already-schema-validated Go value rather than arbitrary CRD
+// input, but an expression like a nested comprehension over a large slice
+// is exactly as able to blow up evaluation here as there.
+const celPerCallCostLimit = uint64(1_000_000)
+
+// CompiledCEL is a k8s:cel predicate compiled by MustCompileCEL and
+// evaluated by MatchesCEL. The expression refers to the validated value
+// as `self`, e.g. `self in [1, 2, 3] || self > 100`.
+type CompiledCEL struct {
+	program celgo.Program
+	source  string
+}
+
+// MustCompileCEL compiles expr into a CompiledCEL, or panics if expr fails
+// to compile or does not evaluate to a bool. Generated code calls this
+// inline, as an argument of each MatchesCEL call (the same way `+k8s:pattern`
+// passes an inline regexp.MustCompile), so expr is parsed, type-checked and
+// compiled on every evaluation; the result is not kept in a package-level
+// variable.
+func MustCompileCEL(expr string) *CompiledCEL {
+	env, err := celgo.NewEnv(celgo.Variable("self", celgo.DynType))
+	if err != nil {
+		panic(fmt.Sprintf("k8s:cel: building CEL environment: %v", err))
+	}
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		panic(fmt.Sprintf("k8s:cel: compiling %q: %v", expr, issues.Err()))
+	}
+	if ast.OutputType() != celgo.BoolType {
+		panic(fmt.Sprintf("k8s:cel: expression %q must evaluate to a bool, got %s", expr, ast.OutputType()))
+	}
+	program, err := env.Program(ast, celgo.CostLimit(celPerCallCostLimit))
+	if err != nil {
+		panic(fmt.Sprintf("k8s:cel: building program for %q: %v", expr, err))
+	}
+	return &CompiledCEL{program: program, source: expr}
+}
+
+// MatchesCEL validates that compiled's predicate evaluates true with self
+// bound to value. A CEL evaluation error -- including the per-call cost
+// limit in celPerCallCostLimit being exceeded -- and a false result are
+// both reported as a field.Invalid with origin k8s:cel: both mean value
+// fails the predicate. The detail differs: an evaluation error's detail
+// carries the rule and the error, a false result's detail the rule alone.
+func MatchesCEL[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, compiled *CompiledCEL) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	out, _, err := compiled.program.Eval(map[string]interface{}{"self": *value})
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("failed to evaluate CEL rule %q: %v", compiled.source, err)).WithOrigin("k8s:cel")}
+	}
+	if ok, isBool := out.Value().(bool); !isBool || !ok {
+		return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("failed rule: %s", compiled.source)).WithOrigin("k8s:cel")}
+	}
+	return nil
+}
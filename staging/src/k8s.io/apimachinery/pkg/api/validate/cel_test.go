@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestMatchesCEL(t *testing.T) {
+	path := field.NewPath("test")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Create}
+
+	cases := []struct {
+		name    string
+		expr    string
+		value   int
+		wantErr bool
+	}{
+		{"in allow-list", `self in [1, 2, 3] || self > 100`, 2, false},
+		{"above threshold", `self in [1, 2, 3] || self > 100`, 500, false},
+		{"neither", `self in [1, 2, 3] || self > 100`, 50, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			compiled := MustCompileCEL(c.expr)
+			errs := MatchesCEL(ctx, op, path, &c.value, nil, compiled)
+			if c.wantErr && len(errs) == 0 {
+				t.Errorf("expected an error, got none")
+			}
+			if !c.wantErr && len(errs) != 0 {
+				t.Errorf("expected no errors, got %s", fmtErrs(errs))
+			}
+		})
+	}
+}
+
+func TestMatchesCELNilValue(t *testing.T) {
+	compiled := MustCompileCEL(`self > 0`)
+	errs := MatchesCEL[int](context.Background(), operation.Operation{Type: operation.Create}, field.NewPath("test"), nil, nil, compiled)
+	if len(errs) != 0 {
+		t.Errorf("expected no errors for a nil value, got %s", fmtErrs(errs))
+	}
+}
+
+func TestMustCompileCELPanicsOnNonBoolExpression(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic for a non-bool expression")
+		}
+	}()
+	MustCompileCEL(`self + 1`)
+}
+
+func TestMustCompileCELPanicsOnInvalidExpression(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("expected a panic for an invalid expression")
+		}
+		if !strings.Contains(r.(string), "k8s:cel") {
+			t.Errorf("expected panic message to mention k8s:cel, got %q", r)
+		}
+	}()
+	MustCompileCEL(`self +`)
+}
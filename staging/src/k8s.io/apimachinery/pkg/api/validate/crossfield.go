@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// EqField validates that the field named fieldName is equal to the sibling
+// field named referenceName, both read from newObj via the caller-provided
+// accessors. For update operations, re-validation is skipped (ratcheted) if
+// neither field's value changed. The reported error is rooted at fieldName,
+// not referenceName.
+func EqField[T any, F comparable](_ context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *T, fieldName, referenceName string, getField, getReference func(*T) *F) field.ErrorList {
+	newVal, newRef := getField(newObj), getReference(newObj)
+	if op.Type == operation.Update && oldObj != nil {
+		oldVal, oldRef := getField(oldObj), getReference(oldObj)
+		if ptrEqual(newVal, oldVal) && ptrEqual(newRef, oldRef) {
+			return nil
+		}
+	}
+	if newVal == nil || newRef == nil || *newVal == *newRef {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath.Child(fieldName), *newVal, fmt.Sprintf("must equal field %q", referenceName)).WithOrigin("k8s:eqField")}
+}
+
+// NeField validates that the field named fieldName is different from the
+// sibling field named referenceName, both read from newObj via the
+// caller-provided accessors. For update operations, re-validation is skipped
+// (ratcheted) if neither field's value changed. The reported error is rooted
+// at fieldName, not referenceName.
+func NeField[T any, F comparable](_ context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *T, fieldName, referenceName string, getField, getReference func(*T) *F) field.ErrorList {
+	newVal, newRef := getField(newObj), getReference(newObj)
+	if op.Type == operation.Update && oldObj != nil {
+		oldVal, oldRef := getField(oldObj), getReference(oldObj)
+		if ptrEqual(newVal, oldVal) && ptrEqual(newRef, oldRef) {
+			return nil
+		}
+	}
+	if newVal == nil || newRef == nil || *newVal != *newRef {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath.Child(fieldName), *newVal, fmt.Sprintf("must not equal field %q", referenceName)).WithOrigin("k8s:neField")}
+}
+
+// ptrEqual reports whether two pointers are both nil, or both non-nil and
+// point to equal values.
+func ptrEqual[F comparable](a, b *F) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
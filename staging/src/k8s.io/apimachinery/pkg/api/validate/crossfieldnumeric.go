@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// LessThanField validates that value is strictly less than ref, the value of
+// the sibling field named refFieldName. Either side may be nil (e.g. an
+// optional field); in that case the comparison is skipped rather than
+// treated as a violation, so a field only becomes constrained once both
+// sides are set. The generated code resolves both accessors once and passes
+// the resulting values in, rather than re-reading them per comparison.
+func LessThanField(_ context.Context, _ operation.Operation, fldPath *field.Path, value, ref *int64, refFieldName string) field.ErrorList {
+	if value == nil || ref == nil || *value < *ref {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be less than field %q", refFieldName)).WithOrigin("k8s:lessThan")}
+}
+
+// LessThanFieldFloat is LessThanField for float32/float64 fields.
+func LessThanFieldFloat(_ context.Context, _ operation.Operation, fldPath *field.Path, value, ref *float64, refFieldName string) field.ErrorList {
+	if value == nil || ref == nil || *value < *ref {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be less than field %q", refFieldName)).WithOrigin("k8s:lessThan")}
+}
+
+// LessThanFieldQuantity is LessThanField for resource.Quantity fields.
+func LessThanFieldQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, ref *resource.Quantity, refFieldName string) field.ErrorList {
+	if value == nil || ref == nil || value.Cmp(*ref) < 0 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be less than field %q", refFieldName)).WithOrigin("k8s:lessThan")}
+}
+
+// GreaterThanField is LessThanField with the comparison reversed: value must
+// be strictly greater than ref. See LessThanField for the nil-handling
+// behavior.
+func GreaterThanField(_ context.Context, _ operation.Operation, fldPath *field.Path, value, ref *int64, refFieldName string) field.ErrorList {
+	if value == nil || ref == nil || *value > *ref {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be greater than field %q", refFieldName)).WithOrigin("k8s:greaterThan")}
+}
+
+// GreaterThanFieldFloat is GreaterThanField for float32/float64 fields.
+func GreaterThanFieldFloat(_ context.Context, _ operation.Operation, fldPath *field.Path, value, ref *float64, refFieldName string) field.ErrorList {
+	if value == nil || ref == nil || *value > *ref {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be greater than field %q", refFieldName)).WithOrigin("k8s:greaterThan")}
+}
+
+// GreaterThanFieldQuantity is GreaterThanField for resource.Quantity fields.
+func GreaterThanFieldQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, ref *resource.Quantity, refFieldName string) field.ErrorList {
+	if value == nil || ref == nil || value.Cmp(*ref) > 0 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be greater than field %q", refFieldName)).WithOrigin("k8s:greaterThan")}
+}
+
+// SumEqualsField validates that target equals the sum of addends, the
+// values of the sibling fields named addendFieldNames (in the same order).
+// As with LessThanField, the generated code resolves every accessor once
+// per call and passes the resulting values in; target or any addend may be
+// nil, in which case the comparison is skipped.
+func SumEqualsField(_ context.Context, _ operation.Operation, fldPath *field.Path, target *int64, addends []*int64, addendFieldNames []string) field.ErrorList {
+	if target == nil {
+		return nil
+	}
+	var sum int64
+	for _, addend := range addends {
+		if addend == nil {
+			return nil
+		}
+		sum += *addend
+	}
+	if *target == sum {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *target, fmt.Sprintf("must equal the sum of fields %s", strings.Join(addendFieldNames, ", "))).WithOrigin("k8s:sumEquals")}
+}
+
+// SumEqualsFieldFloat is SumEqualsField for float32/float64 fields.
+func SumEqualsFieldFloat(_ context.Context, _ operation.Operation, fldPath *field.Path, target *float64, addends []*float64, addendFieldNames []string) field.ErrorList {
+	if target == nil {
+		return nil
+	}
+	var sum float64
+	for _, addend := range addends {
+		if addend == nil {
+			return nil
+		}
+		sum += *addend
+	}
+	if *target == sum {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *target, fmt.Sprintf("must equal the sum of fields %s", strings.Join(addendFieldNames, ", "))).WithOrigin("k8s:sumEquals")}
+}
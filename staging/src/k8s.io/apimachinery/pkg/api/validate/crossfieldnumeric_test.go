@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestLessThanField(t *testing.T) {
+	path := field.NewPath("test")
+
+	lo, hi := int64(1), int64(2)
+	if errs := LessThanField(context.Background(), operation.Operation{}, path, &lo, &hi, "hi"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	if errs := LessThanField(context.Background(), operation.Operation{}, path, &hi, &lo, "lo"); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	if errs := LessThanField(context.Background(), operation.Operation{}, path, nil, &hi, "hi"); len(errs) != 0 {
+		t.Errorf("expected no errors when value is nil, got %s", fmtErrs(errs))
+	}
+	if errs := LessThanField(context.Background(), operation.Operation{}, path, &lo, nil, "hi"); len(errs) != 0 {
+		t.Errorf("expected no errors when ref is nil, got %s", fmtErrs(errs))
+	}
+}
+
+func TestLessThanFieldQuantity(t *testing.T) {
+	path := field.NewPath("test")
+
+	lo, hi := resource.MustParse("500m"), resource.MustParse("2")
+	if errs := LessThanFieldQuantity(context.Background(), operation.Operation{}, path, &lo, &hi, "hi"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if errs := LessThanFieldQuantity(context.Background(), operation.Operation{}, path, &hi, &lo, "lo"); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestGreaterThanField(t *testing.T) {
+	path := field.NewPath("test")
+
+	lo, hi := int64(1), int64(2)
+	if errs := GreaterThanField(context.Background(), operation.Operation{}, path, &hi, &lo, "lo"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	if errs := GreaterThanField(context.Background(), operation.Operation{}, path, &lo, &hi, "hi"); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	if errs := GreaterThanField(context.Background(), operation.Operation{}, path, nil, &lo, "lo"); len(errs) != 0 {
+		t.Errorf("expected no errors when value is nil, got %s", fmtErrs(errs))
+	}
+}
+
+func TestGreaterThanFieldQuantity(t *testing.T) {
+	path := field.NewPath("test")
+
+	lo, hi := resource.MustParse("500m"), resource.MustParse("2")
+	if errs := GreaterThanFieldQuantity(context.Background(), operation.Operation{}, path, &hi, &lo, "lo"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if errs := GreaterThanFieldQuantity(context.Background(), operation.Operation{}, path, &lo, &hi, "hi"); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestSumEqualsField(t *testing.T) {
+	path := field.NewPath("test")
+
+	a, b := int64(2), int64(3)
+	target := int64(5)
+	if errs := SumEqualsField(context.Background(), operation.Operation{}, path, &target, []*int64{&a, &b}, []string{"a", "b"}); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	bad := int64(6)
+	if errs := SumEqualsField(context.Background(), operation.Operation{}, path, &bad, []*int64{&a, &b}, []string{"a", "b"}); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	if errs := SumEqualsField(context.Background(), operation.Operation{}, path, nil, []*int64{&a, &b}, []string{"a", "b"}); len(errs) != 0 {
+		t.Errorf("expected no errors when target is nil, got %s", fmtErrs(errs))
+	}
+	if errs := SumEqualsField(context.Background(), operation.Operation{}, path, &target, []*int64{&a, nil}, []string{"a", "b"}); len(errs) != 0 {
+		t.Errorf("expected no errors when an addend is nil, got %s", fmtErrs(errs))
+	}
+}
+
+func TestSumEqualsFieldFloat(t *testing.T) {
+	path := field.NewPath("test")
+
+	a, b := 2.5, 2.5
+	target := 5.0
+	if errs := SumEqualsFieldFloat(context.Background(), operation.Operation{}, path, &target, []*float64{&a, &b}, []string{"a", "b"}); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	bad := 6.0
+	if errs := SumEqualsFieldFloat(context.Background(), operation.Operation{}, path, &bad, []*float64{&a, &b}, []string{"a", "b"}); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
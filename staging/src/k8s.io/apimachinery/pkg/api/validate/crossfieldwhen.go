@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// RequiredWhen validates that the field named fieldName (read from newObj via
+// getField) is set whenever cond(newObj) holds, reporting field.Required
+// otherwise.
+func RequiredWhen[T any, F any](_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, _ *T, fieldName string, getField func(*T) *F, cond func(*T) bool) field.ErrorList {
+	if !cond(newObj) || getField(newObj) != nil {
+		return nil
+	}
+	return field.ErrorList{field.Required(fldPath.Child(fieldName), "").WithOrigin("k8s:requiredWhen")}
+}
+
+// ForbiddenWhen validates that the field named fieldName (read from newObj
+// via getField) is not set whenever cond(newObj) holds, reporting
+// field.Forbidden otherwise.
+func ForbiddenWhen[T any, F any](_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, _ *T, fieldName string, getField func(*T) *F, cond func(*T) bool) field.ErrorList {
+	if !cond(newObj) || getField(newObj) == nil {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath.Child(fieldName), "").WithOrigin("k8s:forbiddenWhen")}
+}
+
+// ImmutableWhen validates that the field named fieldName, read from newObj
+// and oldObj via getField, does not change in the course of an update
+// whenever cond(newObj) holds. cond is evaluated against newObj, so a
+// predicate that becomes true as part of the very update that changes
+// fieldName (e.g. a sibling "phase" field transitioning into the state that
+// locks fieldName) still forbids that change. It does nothing for create
+// operations.
+func ImmutableWhen[T any, F comparable](_ context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *T, fieldName string, getField func(*T) *F, cond func(*T) bool) field.ErrorList {
+	if op.Type != operation.Update || oldObj == nil {
+		return nil
+	}
+	if !cond(newObj) {
+		return nil
+	}
+	if ptrEqual(getField(newObj), getField(oldObj)) {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath.Child(fieldName), "field is immutable").WithOrigin("k8s:immutableWhen")}
+}
+
+// MutuallyExclusive validates that at most one of fieldNames has a value,
+// according to isSet(newObj), whose returned []bool is indexed the same as
+// fieldNames.
+func MutuallyExclusive[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, _ *T, fieldNames []string, isSet func(*T) []bool) field.ErrorList {
+	var set []string
+	for i, ok := range isSet(newObj) {
+		if ok {
+			set = append(set, fieldNames[i])
+		}
+	}
+	if len(set) <= 1 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, set, fmt.Sprintf("at most one of %s may be set", strings.Join(fieldNames, ", "))).WithOrigin("k8s:mutuallyExclusive")}
+}
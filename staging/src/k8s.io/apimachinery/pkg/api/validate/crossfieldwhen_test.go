@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type whenTestObj struct {
+	Mode string
+	A    *string
+	B    *string
+	C    string
+}
+
+func TestRequiredWhen(t *testing.T) {
+	path := field.NewPath("test")
+	isModeX := func(o *whenTestObj) bool { return o.Mode == "X" }
+	getA := func(o *whenTestObj) *string { return o.A }
+
+	set := "set"
+	if errs := RequiredWhen(context.Background(), operation.Operation{}, path, &whenTestObj{Mode: "X", A: &set}, nil, "a", getA, isModeX); len(errs) != 0 {
+		t.Errorf("expected no errors when set, got %s", fmtErrs(errs))
+	}
+	if errs := RequiredWhen(context.Background(), operation.Operation{}, path, &whenTestObj{Mode: "Y"}, nil, "a", getA, isModeX); len(errs) != 0 {
+		t.Errorf("expected no errors when cond is false, got %s", fmtErrs(errs))
+	}
+
+	errs := RequiredWhen(context.Background(), operation.Operation{}, path, &whenTestObj{Mode: "X"}, nil, "a", getA, isModeX)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestForbiddenWhen(t *testing.T) {
+	path := field.NewPath("test")
+	isModeX := func(o *whenTestObj) bool { return o.Mode == "X" }
+	getA := func(o *whenTestObj) *string { return o.A }
+
+	if errs := ForbiddenWhen(context.Background(), operation.Operation{}, path, &whenTestObj{Mode: "Y"}, nil, "a", getA, isModeX); len(errs) != 0 {
+		t.Errorf("expected no errors when cond is false, got %s", fmtErrs(errs))
+	}
+	if errs := ForbiddenWhen(context.Background(), operation.Operation{}, path, &whenTestObj{Mode: "X"}, nil, "a", getA, isModeX); len(errs) != 0 {
+		t.Errorf("expected no errors when unset, got %s", fmtErrs(errs))
+	}
+
+	set := "set"
+	errs := ForbiddenWhen(context.Background(), operation.Operation{}, path, &whenTestObj{Mode: "X", A: &set}, nil, "a", getA, isModeX)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestImmutableWhen(t *testing.T) {
+	path := field.NewPath("test")
+	update := operation.Operation{Type: operation.Update}
+	isModeX := func(o *whenTestObj) bool { return o.Mode == "X" }
+	getC := func(o *whenTestObj) *string { return &o.C }
+
+	// cond true, field changed: forbidden.
+	errs := ImmutableWhen(context.Background(), update, path, &whenTestObj{Mode: "X", C: "new"}, &whenTestObj{Mode: "X", C: "old"}, "c", getC, isModeX)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	// cond true, field unchanged: allowed.
+	if errs := ImmutableWhen(context.Background(), update, path, &whenTestObj{Mode: "X", C: "same"}, &whenTestObj{Mode: "X", C: "same"}, "c", getC, isModeX); len(errs) != 0 {
+		t.Errorf("expected no errors when unchanged, got %s", fmtErrs(errs))
+	}
+
+	// cond false, field changed: allowed.
+	if errs := ImmutableWhen(context.Background(), update, path, &whenTestObj{Mode: "Y", C: "new"}, &whenTestObj{Mode: "Y", C: "old"}, "c", getC, isModeX); len(errs) != 0 {
+		t.Errorf("expected no errors when cond is false, got %s", fmtErrs(errs))
+	}
+
+	// cond transitions false->true in the same update that changes the field: forbidden, since cond is evaluated against the new object.
+	errs = ImmutableWhen(context.Background(), update, path, &whenTestObj{Mode: "X", C: "new"}, &whenTestObj{Mode: "Y", C: "old"}, "c", getC, isModeX)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error when cond transitions to true, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMutuallyExclusive(t *testing.T) {
+	path := field.NewPath("test")
+	names := []string{"a", "b"}
+	isSet := func(o *whenTestObj) []bool { return []bool{o.A != nil, o.B != nil} }
+
+	if errs := MutuallyExclusive(context.Background(), operation.Operation{}, path, &whenTestObj{}, nil, names, isSet); len(errs) != 0 {
+		t.Errorf("expected no errors when neither is set, got %s", fmtErrs(errs))
+	}
+
+	set := "set"
+	if errs := MutuallyExclusive(context.Background(), operation.Operation{}, path, &whenTestObj{A: &set}, nil, names, isSet); len(errs) != 0 {
+		t.Errorf("expected no errors when only one is set, got %s", fmtErrs(errs))
+	}
+
+	errs := MutuallyExclusive(context.Background(), operation.Operation{}, path, &whenTestObj{A: &set, B: &set}, nil, names, isSet)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// defaultDiffMaxDepth and defaultDiffMaxPaths bound the walk in
+// changedSubpaths so a pathological (very deep or very wide) value can't
+// blow up validation latency.
+const (
+	defaultDiffMaxDepth = 8
+	defaultDiffMaxPaths = 3
+)
+
+// changedSubpaths walks a and b structurally, using reflectEqual to decide
+// whether corresponding subtrees differ (so nil and empty maps/slices are
+// treated as equal via equality.Semantic, any type implementing Equaler
+// compares via its own EqualTo, and any other registered custom equality
+// funcs are respected), and returns up to maxPaths dotted subpaths -- e.g.
+// ".spec.replicas" -- at which they differ. It stops descending once depth
+// reaches maxDepth, reporting the subtree itself as changed rather than
+// recursing further, and stops walking entirely once maxPaths have been
+// found.
+func changedSubpaths(a, b interface{}, maxDepth, maxPaths int) []string {
+	if reflectEqual(a, b) {
+		return nil
+	}
+	var out []string
+	walkDiff(reflect.ValueOf(a), reflect.ValueOf(b), "", 0, maxDepth, maxPaths, &out)
+	return out
+}
+
+// diffDetail renders changedSubpaths(a, b, ...) as a parenthesized
+// "(changed: ...)" suffix for use in a field.Error.Detail, or "" if no
+// subpaths could be identified (e.g. the values differ only at the root,
+// such as two totally unrelated scalars).
+func diffDetail(a, b interface{}) string {
+	paths := changedSubpaths(a, b, defaultDiffMaxDepth, defaultDiffMaxPaths)
+	if len(paths) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (changed: %s)", strings.Join(paths, ", "))
+}
+
+func walkDiff(a, b reflect.Value, path string, depth, maxDepth, maxPaths int, out *[]string) {
+	if len(*out) >= maxPaths {
+		return
+	}
+	if reflectEqual(safeInterface(a), safeInterface(b)) {
+		return
+	}
+	if depth >= maxDepth {
+		*out = append(*out, path)
+		return
+	}
+
+	a = deref(a)
+	b = deref(b)
+
+	if !a.IsValid() || !b.IsValid() || a.Kind() != b.Kind() {
+		*out = append(*out, path)
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < a.NumField() && len(*out) < maxPaths; i++ {
+			if t.Field(i).PkgPath != "" {
+				continue // unexported
+			}
+			walkDiff(a.Field(i), b.Field(i), path+"."+fieldDiffName(t.Field(i)), depth+1, maxDepth, maxPaths, out)
+		}
+	case reflect.Slice, reflect.Array:
+		n := a.Len()
+		if b.Len() > n {
+			n = b.Len()
+		}
+		for i := 0; i < n && len(*out) < maxPaths; i++ {
+			var av, bv reflect.Value
+			if i < a.Len() {
+				av = a.Index(i)
+			}
+			if i < b.Len() {
+				bv = b.Index(i)
+			}
+			walkDiff(av, bv, fmt.Sprintf("%s[%d]", path, i), depth+1, maxDepth, maxPaths, out)
+		}
+	case reflect.Map:
+		seen := map[string]bool{}
+		for _, k := range a.MapKeys() {
+			if len(*out) >= maxPaths {
+				break
+			}
+			key := fmt.Sprintf("%v", k.Interface())
+			seen[key] = true
+			walkDiff(a.MapIndex(k), b.MapIndex(k), fmt.Sprintf("%s[%s]", path, key), depth+1, maxDepth, maxPaths, out)
+		}
+		for _, k := range b.MapKeys() {
+			if len(*out) >= maxPaths {
+				break
+			}
+			key := fmt.Sprintf("%v", k.Interface())
+			if seen[key] {
+				continue
+			}
+			walkDiff(a.MapIndex(k), b.MapIndex(k), fmt.Sprintf("%s[%s]", path, key), depth+1, maxDepth, maxPaths, out)
+		}
+	default:
+		*out = append(*out, path)
+	}
+}
+
+// changedSubfieldErrors walks a and b the same way changedSubpaths does, but
+// instead of summarizing differences into a single dotted-string list,
+// returns one field.Error per differing leaf, each carrying its own
+// fully-qualified *field.Path rooted at fldPath (e.g.
+// fldPath.Child("containers").Index(0).Child("image")) -- the detail an
+// immutability rejection needs to point a caller straight at what changed,
+// rather than a path they'd have to parse back out of a string. It shares
+// changedSubpaths' maxDepth/maxPaths bounds: the walk stops descending at
+// maxDepth (reporting the subtree itself as one error) and stops entirely
+// once maxPaths errors have been collected.
+func changedSubfieldErrors(reason string, fldPath *field.Path, a, b interface{}, maxDepth, maxPaths int) field.ErrorList {
+	if reflectEqual(a, b) {
+		return nil
+	}
+	var out field.ErrorList
+	walkDiffField(reason, reflect.ValueOf(a), reflect.ValueOf(b), fldPath, 0, maxDepth, maxPaths, &out)
+	return out
+}
+
+func walkDiffField(reason string, a, b reflect.Value, fldPath *field.Path, depth, maxDepth, maxPaths int, out *field.ErrorList) {
+	if len(*out) >= maxPaths {
+		return
+	}
+	if reflectEqual(safeInterface(a), safeInterface(b)) {
+		return
+	}
+	if depth >= maxDepth {
+		*out = append(*out, field.Forbidden(fldPath, reason))
+		return
+	}
+
+	a = deref(a)
+	b = deref(b)
+
+	if !a.IsValid() || !b.IsValid() || a.Kind() != b.Kind() {
+		*out = append(*out, field.Forbidden(fldPath, reason))
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < a.NumField() && len(*out) < maxPaths; i++ {
+			if t.Field(i).PkgPath != "" {
+				continue // unexported
+			}
+			walkDiffField(reason, a.Field(i), b.Field(i), fldPath.Child(fieldDiffName(t.Field(i))), depth+1, maxDepth, maxPaths, out)
+		}
+	case reflect.Slice, reflect.Array:
+		n := a.Len()
+		if b.Len() > n {
+			n = b.Len()
+		}
+		for i := 0; i < n && len(*out) < maxPaths; i++ {
+			var av, bv reflect.Value
+			if i < a.Len() {
+				av = a.Index(i)
+			}
+			if i < b.Len() {
+				bv = b.Index(i)
+			}
+			walkDiffField(reason, av, bv, fldPath.Index(i), depth+1, maxDepth, maxPaths, out)
+		}
+	case reflect.Map:
+		seen := map[string]bool{}
+		for _, k := range a.MapKeys() {
+			if len(*out) >= maxPaths {
+				break
+			}
+			key := fmt.Sprintf("%v", k.Interface())
+			seen[key] = true
+			walkDiffField(reason, a.MapIndex(k), b.MapIndex(k), fldPath.Key(key), depth+1, maxDepth, maxPaths, out)
+		}
+		for _, k := range b.MapKeys() {
+			if len(*out) >= maxPaths {
+				break
+			}
+			key := fmt.Sprintf("%v", k.Interface())
+			if seen[key] {
+				continue
+			}
+			walkDiffField(reason, a.MapIndex(k), b.MapIndex(k), fldPath.Key(key), depth+1, maxDepth, maxPaths, out)
+		}
+	default:
+		*out = append(*out, field.Forbidden(fldPath, reason))
+	}
+}
+
+// fieldDiffName prefers a struct field's JSON name (as used in field.Path
+// elsewhere in this package) over its Go name, falling back to the Go name
+// if there is no json tag.
+func fieldDiffName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "" || tag == "-" {
+		return f.Name
+	}
+	if i := strings.IndexByte(tag, ','); i >= 0 {
+		tag = tag[:i]
+	}
+	if tag == "" {
+		return f.Name
+	}
+	return tag
+}
+
+// deref unwraps pointers and interfaces, returning the zero Value if it
+// bottoms out at nil.
+func deref(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// safeInterface calls Value.Interface(), returning nil instead of panicking
+// for the zero Value (which IsValid() reports as invalid, e.g. a nil map
+// entry returned by MapIndex for an absent key).
+func safeInterface(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
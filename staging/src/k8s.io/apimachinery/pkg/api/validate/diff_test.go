@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type diffContainer struct {
+	Image string `json:"image"`
+}
+
+type diffSpec struct {
+	Replicas   int             `json:"replicas"`
+	Containers []diffContainer `json:"containers"`
+}
+
+func TestChangedSubpaths(t *testing.T) {
+	a := diffSpec{Replicas: 3, Containers: []diffContainer{{Image: "v1"}}}
+	b := diffSpec{Replicas: 3, Containers: []diffContainer{{Image: "v1"}}}
+	if got := changedSubpaths(a, b, defaultDiffMaxDepth, defaultDiffMaxPaths); got != nil {
+		t.Errorf("expected no subpaths for equal values, got %v", got)
+	}
+
+	b.Replicas = 5
+	b.Containers[0].Image = "v2"
+	got := changedSubpaths(a, b, defaultDiffMaxDepth, defaultDiffMaxPaths)
+	want := []string{".containers[0].image", ".replicas"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for _, w := range want {
+		found := false
+		for _, g := range got {
+			if g == w {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %q in %v", w, got)
+		}
+	}
+}
+
+func TestChangedSubpathsMaxPaths(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5}
+	b := []int{6, 7, 8, 9, 10}
+	got := changedSubpaths(a, b, defaultDiffMaxDepth, 2)
+	if len(got) != 2 {
+		t.Errorf("expected exactly 2 subpaths (maxPaths), got %v", got)
+	}
+}
+
+func TestChangedSubpathsMaxDepth(t *testing.T) {
+	a := diffSpec{Containers: []diffContainer{{Image: "v1"}}}
+	b := diffSpec{Containers: []diffContainer{{Image: "v2"}}}
+	got := changedSubpaths(a, b, 1, defaultDiffMaxPaths)
+	if len(got) != 1 || got[0] != ".containers" {
+		t.Errorf("expected the walk to stop at depth 1 and report the whole slice, got %v", got)
+	}
+}
+
+func TestChangedSubpathsNilVsEmpty(t *testing.T) {
+	var nilSlice []int
+	emptySlice := []int{}
+	if got := changedSubpaths(nilSlice, emptySlice, defaultDiffMaxDepth, defaultDiffMaxPaths); got != nil {
+		t.Errorf("expected nil and empty slices to be treated as equal, got %v", got)
+	}
+}
+
+func TestChangedSubfieldErrors(t *testing.T) {
+	path := field.NewPath("spec")
+	a := diffSpec{Replicas: 3, Containers: []diffContainer{{Image: "v1"}}}
+	b := diffSpec{Replicas: 3, Containers: []diffContainer{{Image: "v1"}}}
+	if errs := changedSubfieldErrors("changed", path, a, b, defaultDiffMaxDepth, defaultDiffMaxPaths); len(errs) != 0 {
+		t.Errorf("expected no errors for equal values, got %v", errs)
+	}
+
+	b.Replicas = 5
+	b.Containers[0].Image = "v2"
+	errs := changedSubfieldErrors("changed", path, a, b, defaultDiffMaxDepth, defaultDiffMaxPaths)
+	want := map[string]bool{"spec.replicas": true, "spec.containers[0].image": true}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %v, got %v", want, errs)
+	}
+	for _, e := range errs {
+		if !want[e.Field] {
+			t.Errorf("unexpected error field %q", e.Field)
+		}
+		if e.Detail != "changed" {
+			t.Errorf("expected detail %q, got %q", "changed", e.Detail)
+		}
+	}
+}
+
+func TestChangedSubfieldErrorsMaxPaths(t *testing.T) {
+	path := field.NewPath("items")
+	a := []int{1, 2, 3, 4, 5}
+	b := []int{6, 7, 8, 9, 10}
+	errs := changedSubfieldErrors("changed", path, a, b, defaultDiffMaxDepth, 2)
+	if len(errs) != 2 {
+		t.Errorf("expected exactly 2 errors (maxPaths), got %v", errs)
+	}
+}
+
+func TestDiffDetail(t *testing.T) {
+	a := diffSpec{Replicas: 1}
+	b := diffSpec{Replicas: 2}
+	got := diffDetail(a, b)
+	if !strings.Contains(got, "changed: .replicas") {
+		t.Errorf("expected detail to mention .replicas, got %q", got)
+	}
+
+	if got := diffDetail(a, a); got != "" {
+		t.Errorf("expected no detail for equal values, got %q", got)
+	}
+}
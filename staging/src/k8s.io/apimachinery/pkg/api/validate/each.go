@@ -0,0 +1,232 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateFunc validates newVal (and, for updates, oldVal) and returns any
+// errors found, rooted at fldPath.
+type ValidateFunc[T any] func(ctx context.Context, op operation.Operation, fldPath *field.Path, newVal, oldVal T) field.ErrorList
+
+// CompareFunc returns true if a and b should be considered the same value.
+// It is used both to find a list item's counterpart across an update (match)
+// and to decide whether that counterpart is unchanged (equiv).
+type CompareFunc[T any] func(a, b T) bool
+
+// DirectEqual is a CompareFunc for comparable types that uses Go's built-in
+// == operator.
+func DirectEqual[T comparable](a, b T) bool {
+	return a == b
+}
+
+// SemanticDeepEqual is a CompareFunc that uses apimachinery's semantic
+// deep-equal, which understands types like resource.Quantity and metav1.Time.
+// It works for types that are not directly comparable, at the cost of
+// performance.
+func SemanticDeepEqual[T any](a, b T) bool {
+	return equality.Semantic.DeepEqual(a, b)
+}
+
+// EachSliceVal calls validator once for each element of newSlice.  For update
+// operations, if match can find a corresponding element in oldSlice, that
+// element is passed as oldVal, and if equiv reports the two elements as
+// unchanged (or equiv is nil), re-validation of that element is skipped
+// (ratcheting).  match performs a linear scan of oldSlice for each element of
+// newSlice; for +k8s:listType=map fields with one or more +k8s:listMapKey
+// fields, prefer EachSliceValMap, which builds an index over oldSlice once.
+func EachSliceVal[T any](ctx context.Context, op operation.Operation, fldPath *field.Path, newSlice, oldSlice []T, match, equiv CompareFunc[T], validator ValidateFunc[*T]) field.ErrorList {
+	var errs field.ErrorList
+	for i := range newSlice {
+		val := &newSlice[i]
+		var old *T
+		if match != nil && len(oldSlice) > 0 {
+			old = lookup(oldSlice, *val, match)
+		}
+		if op.Type == operation.Update && old != nil && (equiv == nil || equiv(*val, *old)) {
+			continue
+		}
+		errs = append(errs, validator(ctx, op, fldPath.Index(i), val, old)...)
+	}
+	return errs
+}
+
+// KeyFunc extracts a comparable lookup key from a list-map item, typically
+// composed from the item's +k8s:listMapKey fields via ListMapKey.
+type KeyFunc[T any] func(*T) string
+
+// EachSliceValMap behaves like EachSliceVal, but for +k8s:listType=map fields
+// whose items carry a stable key (derived from their +k8s:listMapKey
+// fields). Instead of doing a linear scan of oldSlice for every element of
+// newSlice (O(N*M)), it builds a map[key]*T index over oldSlice once, making
+// ratcheting lookups O(1) per element and the whole pass O(N+M).
+func EachSliceValMap[T any](ctx context.Context, op operation.Operation, fldPath *field.Path, newSlice, oldSlice []T, keyFn KeyFunc[T], equiv CompareFunc[T], validator ValidateFunc[*T]) field.ErrorList {
+	var errs field.ErrorList
+	var oldByKey map[string]*T
+	if len(oldSlice) > 0 {
+		oldByKey = make(map[string]*T, len(oldSlice))
+		for i := range oldSlice {
+			oldByKey[keyFn(&oldSlice[i])] = &oldSlice[i]
+		}
+	}
+	for i := range newSlice {
+		val := &newSlice[i]
+		var old *T
+		if oldByKey != nil {
+			old = oldByKey[keyFn(val)]
+		}
+		if op.Type == operation.Update && old != nil && (equiv == nil || equiv(*val, *old)) {
+			continue
+		}
+		errs = append(errs, validator(ctx, op, fldPath.Index(i), val, old)...)
+	}
+	return errs
+}
+
+// ListMapKey composes a collision-resistant lookup key for a +k8s:listType=map
+// item out of its declared +k8s:listMapKey field values.  Each part is
+// prefixed with its own length so that, e.g., the two-key items ["ab", "c"]
+// and ["a", "bc"] never produce the same key.
+func ListMapKey(parts ...string) string {
+	var b strings.Builder
+	for _, p := range parts {
+		fmt.Fprintf(&b, "%d:%s/", len(p), p)
+	}
+	return b.String()
+}
+
+// lookup returns a pointer to the first element in the list that matches the
+// target, according to the provided comparison function, or else nil.
+func lookup[T any](list []T, target T, cmp CompareFunc[T]) *T {
+	for i := range list {
+		if cmp(list[i], target) {
+			return &list[i]
+		}
+	}
+	return nil
+}
+
+// EachMapVal calls validator once for each value of newMap, in ascending key
+// order (so that error paths are stable across calls).  For update
+// operations, if oldMap has a value under the same key, that value is passed
+// as oldVal.  Map keys provide a natural O(1) lookup, so no match/equiv
+// functions are needed.
+func EachMapVal[K cmp.Ordered, T any](ctx context.Context, op operation.Operation, fldPath *field.Path, newMap, oldMap map[K]T, validator ValidateFunc[*T]) field.ErrorList {
+	var errs field.ErrorList
+	for _, k := range sortedKeys(newMap) {
+		val := newMap[k]
+		var old *T
+		if oldMap != nil {
+			if o, found := oldMap[k]; found {
+				old = &o
+			}
+		}
+		errs = append(errs, validator(ctx, op, fldPath.Key(fmt.Sprintf("%v", k)), &val, old)...)
+	}
+	return errs
+}
+
+// EachMapKey calls validator once for each key of newMap, in ascending key
+// order (so that error paths are stable across calls). It is typically used
+// to validate the keys themselves (e.g. that they match a format),
+// independent of their values.
+func EachMapKey[K cmp.Ordered, T any](ctx context.Context, op operation.Operation, fldPath *field.Path, newMap, oldMap map[K]T, validator ValidateFunc[*K]) field.ErrorList {
+	var errs field.ErrorList
+	for _, k := range sortedKeys(newMap) {
+		key := k
+		errs = append(errs, validator(ctx, op, fldPath.Key(fmt.Sprintf("%v", k)), &key, nil)...)
+	}
+	return errs
+}
+
+// sortedKeys returns the keys of m in ascending order, for deterministic
+// iteration over a Go map.
+func sortedKeys[K cmp.Ordered, T any](m map[K]T) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// UniqueByCompare verifies that all elements of list are unique, using Go's
+// built-in == operator.  It does nothing with oldList; uniqueness is a
+// property of the value, not of the update.
+func UniqueByCompare[T comparable](_ context.Context, _ operation.Operation, fldPath *field.Path, list, _ []T) field.ErrorList {
+	var errs field.ErrorList
+	seen := make(map[T]bool, len(list))
+	for i, v := range list {
+		if seen[v] {
+			errs = append(errs, field.Duplicate(fldPath.Index(i), v))
+			continue
+		}
+		seen[v] = true
+	}
+	return errs
+}
+
+// UniqueByReflect verifies that all elements of list are unique, using
+// reflect.DeepEqual. Unlike UniqueByCompare, this works for types that are
+// not directly comparable, at the cost of an O(N^2) scan.
+func UniqueByReflect[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, list, _ []T) field.ErrorList {
+	var errs field.ErrorList
+	for i := range list {
+		for j := 0; j < i; j++ {
+			if reflect.DeepEqual(list[i], list[j]) {
+				errs = append(errs, field.Duplicate(fldPath.Index(i), list[i]))
+				break
+			}
+		}
+	}
+	return errs
+}
+
+// UniqueByFunc verifies that all elements of list are unique, as determined
+// by the caller-provided comparison function. This is used when uniqueness is
+// defined by something other than the whole value, e.g. a subset of fields.
+func UniqueByFunc[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, list, _ []T, cmp func(a, b T) bool) field.ErrorList {
+	var errs field.ErrorList
+	for i := range list {
+		for j := 0; j < i; j++ {
+			if cmp(list[i], list[j]) {
+				errs = append(errs, field.Duplicate(fldPath.Index(i), list[i]))
+				break
+			}
+		}
+	}
+	return errs
+}
+
+// fmtErrs formats a field.ErrorList for use in test failure messages.
+func fmtErrs(errs field.ErrorList) string {
+	var b strings.Builder
+	for _, e := range errs {
+		fmt.Fprintf(&b, "\n\t%s", e.Error())
+	}
+	return b.String()
+}
@@ -19,13 +19,63 @@ package validate
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/operation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// eqOneOfMaxListed caps how many allowed/disallowed values eqOneOfCheck and
+// NotIn will list by name in an error message, so that a large set doesn't
+// produce an unreadable (or unbounded) message.
+const eqOneOfMaxListed = 20
+
+// formatSortedValues renders values as strings, sorted for a deterministic
+// message, truncating to eqOneOfMaxListed entries with a "(and N more)"
+// suffix.
+func formatSortedValues[T any](values []T) []string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = fmt.Sprintf("%v", v)
+	}
+	sort.Strings(strs)
+	if len(strs) > eqOneOfMaxListed {
+		omitted := len(strs) - eqOneOfMaxListed
+		strs = append(strs[:eqOneOfMaxListed], fmt.Sprintf("(and %d more)", omitted))
+	}
+	return strs
+}
+
 // EqOneOf validates that the specified comparable value is equal to one of the allowed values.
 func EqOneOf[T comparable](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, allowed []T) field.ErrorList {
+	if err := eqOneOfCheck(fldPath, value, allowed); err != nil {
+		return field.ErrorList{err}
+	}
+	return nil
+}
+
+// EqOneOfWarn is like EqOneOf, but for tags declared with `action=warn`: a
+// violation is reported as a warning rather than a denial.
+func EqOneOfWarn[T comparable](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, allowed []T) Result {
+	if err := eqOneOfCheck(fldPath, value, allowed); err != nil {
+		return Result{Warnings: []string{err.Error()}}
+	}
+	return Result{}
+}
+
+// EqOneOfAudit is like EqOneOf, but for tags declared with `action=audit`: a
+// violation is recorded as an audit annotation rather than a denial.
+func EqOneOfAudit[T comparable](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, allowed []T) Result {
+	if err := eqOneOfCheck(fldPath, value, allowed); err != nil {
+		return Result{AuditAnnotations: map[string]string{"k8s:eqOneOf/" + fldPath.String(): err.Error()}}
+	}
+	return Result{}
+}
+
+// eqOneOfCheck holds the comparison shared by EqOneOf and its scoped
+// (warn/audit) variants.
+func eqOneOfCheck[T comparable](fldPath *field.Path, value *T, allowed []T) *field.Error {
 	if value == nil {
 		return nil
 	}
@@ -34,12 +84,38 @@ func EqOneOf[T comparable](_ context.Context, _ operation.Operation, fldPath *fi
 			return nil
 		}
 	}
-	// Convert allowed values to strings for NotSupported fn.
-	allowedStrs := make([]string, len(allowed))
-	for i, v := range allowed {
-		allowedStrs[i] = fmt.Sprintf("%v", v)
+	return field.NotSupported(fldPath, fmt.Sprintf("%v", *value), formatSortedValues(allowed)).WithOrigin("k8s:eqOneOf")
+}
+
+// EqOneOfFold is like EqOneOf, but compares case-insensitively: it is used
+// for tags declared with `caseInsensitive=true`. allowed must already be
+// lowercased by the caller (the generator normalizes the tag's payload at
+// code-generation time), so that this function does no allocation beyond
+// lowercasing value itself.
+func EqOneOfFold(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string, allowed []string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	lower := strings.ToLower(*value)
+	for _, a := range allowed {
+		if lower == a {
+			return nil
+		}
 	}
-	return field.ErrorList{
-		field.NotSupported(fldPath, fmt.Sprintf("%v", *value), allowedStrs).WithOrigin("k8s:eqOneOf"),
+	return field.ErrorList{field.NotSupported(fldPath, *value, formatSortedValues(allowed)).WithOrigin("k8s:eqOneOf")}
+}
+
+// NotIn validates that the specified comparable value is not equal to any of
+// the disallowed values. It is the inverse of EqOneOf.
+func NotIn[T comparable](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, disallowed []T) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	for _, d := range disallowed {
+		if *value == d {
+			msg := fmt.Sprintf("must not be one of: %s", strings.Join(formatSortedValues(disallowed), ", "))
+			return field.ErrorList{field.Invalid(fldPath, *value, msg).WithOrigin("k8s:notIn")}
+		}
 	}
+	return nil
 }
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestEqOneOf(t *testing.T) {
+	allowed := []string{"a", "b"}
+	path := field.NewPath("test")
+
+	val := "a"
+	if errs := EqOneOf(context.Background(), operation.Operation{}, path, &val, nil, allowed); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	bad := "c"
+	if errs := EqOneOf(context.Background(), operation.Operation{}, path, &bad, nil, allowed); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	if errs := EqOneOf[string](context.Background(), operation.Operation{}, path, nil, nil, allowed); len(errs) != 0 {
+		t.Errorf("expected no errors for nil value, got %s", fmtErrs(errs))
+	}
+}
+
+func TestEqOneOfWarn(t *testing.T) {
+	allowed := []string{"a", "b"}
+	path := field.NewPath("test")
+
+	val := "a"
+	if result := EqOneOfWarn(context.Background(), operation.Operation{}, path, &val, nil, allowed); !result.Empty() {
+		t.Errorf("expected empty result, got %+v", result)
+	}
+
+	bad := "c"
+	result := EqOneOfWarn(context.Background(), operation.Operation{}, path, &bad, nil, allowed)
+	if len(result.Errors) != 0 {
+		t.Errorf("expected no errors (action=warn must not deny), got %s", fmtErrs(result.Errors))
+	}
+	if len(result.Warnings) != 1 {
+		t.Errorf("expected 1 warning, got %d: %v", len(result.Warnings), result.Warnings)
+	}
+}
+
+func TestEqOneOfAudit(t *testing.T) {
+	allowed := []string{"a", "b"}
+	path := field.NewPath("test")
+
+	bad := "c"
+	result := EqOneOfAudit(context.Background(), operation.Operation{}, path, &bad, nil, allowed)
+	if len(result.Errors) != 0 || len(result.Warnings) != 0 {
+		t.Errorf("expected no errors or warnings (action=audit must not deny or warn), got %+v", result)
+	}
+	if len(result.AuditAnnotations) != 1 {
+		t.Errorf("expected 1 audit annotation, got %d: %v", len(result.AuditAnnotations), result.AuditAnnotations)
+	}
+}
+
+func TestEqOneOfFold(t *testing.T) {
+	allowed := []string{"a", "b"}
+	path := field.NewPath("test")
+
+	val := "A"
+	if errs := EqOneOfFold(context.Background(), operation.Operation{}, path, &val, nil, allowed); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	bad := "C"
+	if errs := EqOneOfFold(context.Background(), operation.Operation{}, path, &bad, nil, allowed); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestEqOneOfManyValuesTruncated(t *testing.T) {
+	allowed := make([]string, 25)
+	for i := range allowed {
+		allowed[i] = fmt.Sprintf("v%02d", i)
+	}
+	path := field.NewPath("test")
+
+	bad := "nope"
+	errs := EqOneOf(context.Background(), operation.Operation{}, path, &bad, nil, allowed)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "and 5 more") {
+		t.Errorf("expected the error to mention the truncated remainder, got: %v", errs[0])
+	}
+}
+
+func TestNotIn(t *testing.T) {
+	disallowed := []string{"a", "b"}
+	path := field.NewPath("test")
+
+	val := "c"
+	if errs := NotIn(context.Background(), operation.Operation{}, path, &val, nil, disallowed); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	bad := "a"
+	if errs := NotIn(context.Background(), operation.Operation{}, path, &bad, nil, disallowed); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	if errs := NotIn[string](context.Background(), operation.Operation{}, path, nil, nil, disallowed); len(errs) != 0 {
+		t.Errorf("expected no errors for nil value, got %s", fmtErrs(errs))
+	}
+}
+
+func TestResultMerge(t *testing.T) {
+	r := Result{}
+	r.Merge(Result{Warnings: []string{"w1"}})
+	r.Merge(Result{AuditAnnotations: map[string]string{"k": "v"}})
+	if len(r.Warnings) != 1 || r.Warnings[0] != "w1" {
+		t.Errorf("expected warnings [w1], got %v", r.Warnings)
+	}
+	if r.AuditAnnotations["k"] != "v" {
+		t.Errorf("expected audit annotation k=v, got %v", r.AuditAnnotations)
+	}
+	if r.Empty() {
+		t.Errorf("expected non-empty result")
+	}
+}
+
+func TestResultStrictErrors(t *testing.T) {
+	path := field.NewPath("test")
+	r := Result{
+		Errors:   field.ErrorList{field.Invalid(path, "bad", "must be good")},
+		Warnings: []string{"should be good"},
+	}
+	errs := r.StrictErrors()
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	strict := Result{}.StrictErrors()
+	if len(strict) != 0 {
+		t.Errorf("expected no errors for an empty result, got %s", fmtErrs(strict))
+	}
+}
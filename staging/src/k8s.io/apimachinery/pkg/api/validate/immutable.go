@@ -61,14 +61,94 @@ func FrozenByReflect[T any](_ context.Context, op operation.Operation, fldPath *
 	if op.Type != operation.Update {
 		return nil
 	}
-	if !equality.Semantic.DeepEqual(value, oldValue) {
+	if !reflectEqual(value, oldValue) {
 		return field.ErrorList{
-			field.Forbidden(fldPath, "field is frozen"),
+			field.Forbidden(fldPath, "field is frozen"+diffDetail(oldValue, value)),
 		}
 	}
 	return nil
 }
 
+// Equaler is implemented by types that define their own canonical equality
+// -- e.g. resource.Quantity, where "1Ki" and 1024 are the same value despite
+// differing byte-level representations, or a custom duration wrapper that
+// normalizes units before comparing. FrozenByReflect and ImmutableByReflect
+// (and their PerField variants) prefer EqualTo over
+// reflect.DeepEqual/equality.Semantic whenever either the new or the old
+// value implements it, so these validators don't need to special-case such
+// types themselves.
+type Equaler interface {
+	EqualTo(other any) bool
+}
+
+// reflectEqual is what FrozenByReflect/ImmutableByReflect (and the diff walk
+// backing their PerField variants) use in place of a bare
+// equality.Semantic.DeepEqual call: it takes a pointer-identity shortcut for
+// the (hot) unmodified case, then checks value, then oldValue, for an
+// Equaler implementation, before falling back to equality.Semantic.
+func reflectEqual(value, oldValue interface{}) bool {
+	if samePointerIdentity(value, oldValue) {
+		return true
+	}
+	if eq, ok := value.(Equaler); ok {
+		return eq.EqualTo(oldValue)
+	}
+	if eq, ok := oldValue.(Equaler); ok {
+		return eq.EqualTo(value)
+	}
+	return equality.Semantic.DeepEqual(value, oldValue)
+}
+
+// samePointerIdentity reports whether value and oldValue are backed by the
+// exact same pointer, slice header, or map header. This is the common case
+// when a deep-equality field hasn't been touched between old and new at all,
+// and lets reflectEqual skip a full equality.Semantic.DeepEqual walk for it
+// without needing to know the field's static type.
+func samePointerIdentity(value, oldValue interface{}) bool {
+	v := reflect.ValueOf(value)
+	o := reflect.ValueOf(oldValue)
+	if !v.IsValid() || !o.IsValid() || v.Kind() != o.Kind() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		return v.Pointer() == o.Pointer()
+	case reflect.Map:
+		if v.IsNil() || o.IsNil() {
+			return v.IsNil() && o.IsNil()
+		}
+		return v.Pointer() == o.Pointer()
+	case reflect.Slice:
+		if v.IsNil() || o.IsNil() {
+			return v.IsNil() && o.IsNil()
+		}
+		return v.Pointer() == o.Pointer() && v.Len() == o.Len()
+	default:
+		return false
+	}
+}
+
+// FrozenByReflectPerField behaves like FrozenByReflect, but rather than
+// summarizing every changed subpath into a single field.Error's Detail
+// string, returns one field.Error per differing leaf, each at its own
+// fully-qualified *field.Path below fldPath (e.g.
+// fldPath.Child("containers").Index(2).Child("image")). Use this over
+// FrozenByReflect when callers need to act on individual changed fields --
+// a UI surfacing each rejected change separately, for instance -- rather
+// than read a path out of error text.
+func FrozenByReflectPerField[T any](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T) field.ErrorList {
+	if op.Type != operation.Update {
+		return nil
+	}
+	if reflectEqual(value, oldValue) {
+		return nil
+	}
+	if errs := changedSubfieldErrors("field is frozen", fldPath, oldValue, value, defaultDiffMaxDepth, defaultDiffMaxPaths); len(errs) > 0 {
+		return errs
+	}
+	return field.ErrorList{field.Forbidden(fldPath, "field is frozen")}
+}
+
 // ImmutableValueByCompare allows a field to be set
 // once then prevents any further changes.
 // Semantics:
@@ -78,7 +158,7 @@ func FrozenByReflect[T any](_ context.Context, op operation.Operation, fldPath *
 // This function is optimized for comparable types.
 // For non-comparable types use ImmutableByReflect instead.
 func ImmutableValueByCompare[T comparable](ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *T) field.ErrorList {
-	return immutableByCompareCheck(op, fldPath, value, oldValue, isUnsetComparable[T])
+	return immutableByCompareCheck(op, fldPath, value, oldValue, isUnsetComparable[T], "")
 }
 
 // ImmutablePointerByCompare allows a field to be set
@@ -93,7 +173,7 @@ func ImmutableValueByCompare[T comparable](ctx context.Context, op operation.Ope
 func ImmutablePointerByCompare[T comparable](ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *T) field.ErrorList {
 	return immutableByCompareCheck(op, fldPath, value, oldValue, func(v *T) bool {
 		return v == nil
-	})
+	}, "")
 }
 
 // ImmutableByReflect  allows a field to be set
@@ -106,10 +186,37 @@ func ImmutablePointerByCompare[T comparable](ctx context.Context, op operation.O
 // used with types that are not directly comparable
 // at the cost of performance.
 func ImmutableByReflect[T any](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T) field.ErrorList {
+	return immutableByReflectCheck(op, fldPath, value, oldValue, "")
+}
+
+// ImmutableByReflectPerField behaves like ImmutableByReflect, but rather
+// than summarizing every changed subpath into a single field.Error's Detail
+// string, returns one field.Error per differing leaf, each at its own
+// fully-qualified *field.Path below fldPath. See FrozenByReflectPerField for
+// when this is preferable to the Detail-string form.
+func ImmutableByReflectPerField[T any](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T) field.ErrorList {
+	if op.Type != operation.Update {
+		return nil
+	}
+	if reflectEqual(value, oldValue) {
+		return nil
+	}
+	oldValueIsUnset := isUnsetForReflect(oldValue)
+	valueIsUnset := isUnsetForReflect(value)
+	if oldValueIsUnset && !valueIsUnset {
+		return nil
+	}
+	if errs := changedSubfieldErrors("field is immutable", fldPath, oldValue, value, defaultDiffMaxDepth, defaultDiffMaxPaths); len(errs) > 0 {
+		return errs
+	}
+	return field.ErrorList{field.Forbidden(fldPath, "field is immutable")}
+}
+
+func immutableByReflectCheck[T any](op operation.Operation, fldPath *field.Path, value, oldValue T, detail string) field.ErrorList {
 	if op.Type != operation.Update {
 		return nil
 	}
-	if equality.Semantic.DeepEqual(value, oldValue) {
+	if reflectEqual(value, oldValue) {
 		return nil
 	}
 	oldValueIsUnset := isUnsetForReflect(oldValue)
@@ -118,13 +225,13 @@ func ImmutableByReflect[T any](_ context.Context, op operation.Operation, fldPat
 		return nil
 	}
 	return field.ErrorList{
-		field.Forbidden(fldPath, "field is immutable"),
+		field.Forbidden(fldPath, "field is immutable"+diffDetail(oldValue, value)+detail),
 	}
 }
 
 func immutableByCompareCheck[T comparable](op operation.Operation,
 	fldPath *field.Path, value, oldValue *T,
-	isUnset func(*T) bool) field.ErrorList {
+	isUnset func(*T) bool, detail string) field.ErrorList {
 	if op.Type != operation.Update {
 		return nil
 	}
@@ -137,7 +244,7 @@ func immutableByCompareCheck[T comparable](op operation.Operation,
 	}
 	if value == nil {
 		return field.ErrorList{
-			field.Forbidden(fldPath, "field is immutable"),
+			field.Forbidden(fldPath, "field is immutable"+detail),
 		}
 	}
 
@@ -150,7 +257,7 @@ func immutableByCompareCheck[T comparable](op operation.Operation,
 		return nil
 	}
 	return field.ErrorList{
-		field.Forbidden(fldPath, "field is immutable"),
+		field.Forbidden(fldPath, "field is immutable"+detail),
 	}
 }
 
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+// structNonComparableInlineEqual is the comparator a +k8s:immutableDeep-aware
+// code generator could, in principle, emit directly for StructNonComparable
+// instead of falling back to reflection: a hand-unrolled, field-by-field
+// comparison with no reflect.Value involved. It exists only to benchmark that
+// hypothetical generated form against the reflection-based path this
+// generator actually emits today (ImmutableByReflect, via
+// equality.Semantic.DeepEqual), which this checkout's tag validators cannot
+// produce themselves -- doing so would require the generator to recursively
+// walk and emit comparisons for arbitrary nested field types, which is
+// substantially more machinery than a single tag validator has access to.
+func structNonComparableInlineEqual(a, b StructNonComparable) bool {
+	if a.S != b.S || a.I != b.I || a.B != b.B {
+		return false
+	}
+	if (a.SP == nil) != (b.SP == nil) {
+		return false
+	}
+	if a.SP != nil && *a.SP != *b.SP {
+		return false
+	}
+	if (a.IP == nil) != (b.IP == nil) {
+		return false
+	}
+	if a.IP != nil && *a.IP != *b.IP {
+		return false
+	}
+	if (a.BP == nil) != (b.BP == nil) {
+		return false
+	}
+	if a.BP != nil && *a.BP != *b.BP {
+		return false
+	}
+	if len(a.SS) != len(b.SS) {
+		return false
+	}
+	for i := range a.SS {
+		if a.SS[i] != b.SS[i] {
+			return false
+		}
+	}
+	if len(a.MSS) != len(b.MSS) {
+		return false
+	}
+	for k, v := range a.MSS {
+		if bv, ok := b.MSS[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func benchStructNonComparable() (StructNonComparable, StructNonComparable) {
+	a := StructNonComparable{
+		S:   "abc",
+		SP:  ptr.To("abc"),
+		I:   123,
+		IP:  ptr.To(123),
+		B:   true,
+		BP:  ptr.To(true),
+		SS:  []string{"a", "b", "c"},
+		MSS: map[string]string{"a": "b", "c": "d"},
+	}
+	b := StructNonComparable{
+		S:   "abc",
+		SP:  ptr.To("abc"),
+		I:   123,
+		IP:  ptr.To(123),
+		B:   true,
+		BP:  ptr.To(true),
+		SS:  []string{"a", "b", "c"},
+		MSS: map[string]string{"a": "b", "c": "d"},
+	}
+	return a, b
+}
+
+func BenchmarkInlineComparatorEqual(b *testing.B) {
+	x, y := benchStructNonComparable()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !structNonComparableInlineEqual(x, y) {
+			b.Fatal("expected equal")
+		}
+	}
+}
+
+func BenchmarkReflectDeepEqualEqual(b *testing.B) {
+	x, y := benchStructNonComparable()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !reflect.DeepEqual(x, y) {
+			b.Fatal("expected equal")
+		}
+	}
+}
+
+func BenchmarkImmutableByReflectEqual(b *testing.B) {
+	x, y := benchStructNonComparable()
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+	path := field.NewPath("test")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if errs := ImmutableByReflect(ctx, op, path, x, y); len(errs) != 0 {
+			b.Fatalf("expected no errors, got %v", errs)
+		}
+	}
+}
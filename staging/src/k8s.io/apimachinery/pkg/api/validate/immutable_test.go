@@ -279,6 +279,113 @@ func TestFrozenVariantsConsistency(t *testing.T) {
 	}
 }
 
+func TestFrozenByReflectPerField(t *testing.T) {
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+	path := field.NewPath("spec")
+
+	oldValue := diffSpec{Replicas: 3, Containers: []diffContainer{{Image: "v1"}}}
+	newValue := diffSpec{Replicas: 5, Containers: []diffContainer{{Image: "v2"}}}
+
+	errs := FrozenByReflectPerField(ctx, op, path, newValue, oldValue)
+	if len(errs) != 2 {
+		t.Fatalf("expected one error per changed leaf, got %v", errs)
+	}
+	wantPaths := map[string]bool{"spec.replicas": true, "spec.containers[0].image": true}
+	for _, e := range errs {
+		if !wantPaths[e.Field] {
+			t.Errorf("unexpected error field %q", e.Field)
+		}
+		delete(wantPaths, e.Field)
+	}
+	if len(wantPaths) != 0 {
+		t.Errorf("missing expected error fields: %v", wantPaths)
+	}
+
+	if errs := FrozenByReflectPerField(ctx, op, path, oldValue, oldValue); len(errs) != 0 {
+		t.Errorf("expected no errors for equal values, got %v", errs)
+	}
+
+	// nil vs empty slice must still compare equal, matching FrozenByReflect.
+	a := diffSpec{Containers: nil}
+	b := diffSpec{Containers: []diffContainer{}}
+	if errs := FrozenByReflectPerField(ctx, op, path, a, b); len(errs) != 0 {
+		t.Errorf("expected nil and empty Containers to compare equal, got %v", errs)
+	}
+}
+
+func TestImmutableByReflectPerField(t *testing.T) {
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+	path := field.NewPath("spec")
+
+	// unset -> set is the one allowed transition.
+	if errs := ImmutableByReflectPerField(ctx, op, path, diffSpec{Replicas: 3}, diffSpec{}); len(errs) != 0 {
+		t.Errorf("expected unset->set to be allowed, got %v", errs)
+	}
+
+	oldValue := diffSpec{Replicas: 3, Containers: []diffContainer{{Image: "v1"}}}
+	newValue := diffSpec{Replicas: 5, Containers: []diffContainer{{Image: "v1"}}}
+	errs := ImmutableByReflectPerField(ctx, op, path, newValue, oldValue)
+	if len(errs) != 1 || errs[0].Field != "spec.replicas" {
+		t.Fatalf("expected a single error at spec.replicas, got %v", errs)
+	}
+}
+
+// fakeQuantity models a type like resource.Quantity: its canonical value
+// (Canonical) is what EqualTo compares, while Raw is a byte-level
+// representation that can differ between two values that are nonetheless
+// the same quantity (e.g. "1Ki" and "1024").
+type fakeQuantity struct {
+	Canonical int
+	Raw       string
+}
+
+func (q fakeQuantity) EqualTo(other any) bool {
+	o, ok := other.(fakeQuantity)
+	return ok && q.Canonical == o.Canonical
+}
+
+func TestFrozenByReflectEqualer(t *testing.T) {
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+	path := field.NewPath("test")
+
+	// Differing Raw representations of the same Canonical value must be
+	// accepted: EqualTo, not the differing byte-level Raw field, decides.
+	a := fakeQuantity{Canonical: 1024, Raw: "1Ki"}
+	b := fakeQuantity{Canonical: 1024, Raw: "1024"}
+	if errs := FrozenByReflect(ctx, op, path, b, a); len(errs) != 0 {
+		t.Errorf("expected EqualTo to accept equal-canonical values despite differing Raw, got %v", errs)
+	}
+	if errs := FrozenByReflectPerField(ctx, op, path, b, a); len(errs) != 0 {
+		t.Errorf("expected EqualTo to accept equal-canonical values despite differing Raw, got %v", errs)
+	}
+
+	// A differing Canonical value must be rejected.
+	d := fakeQuantity{Canonical: 2048, Raw: "1Ki"}
+	if errs := FrozenByReflect(ctx, op, path, d, a); len(errs) == 0 {
+		t.Errorf("expected EqualTo to reject differing-canonical values, got none")
+	}
+}
+
+func TestImmutableByReflectEqualer(t *testing.T) {
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+	path := field.NewPath("test")
+
+	a := fakeQuantity{Canonical: 1024, Raw: "1Ki"}
+	b := fakeQuantity{Canonical: 1024, Raw: "1024"}
+	if errs := ImmutableByReflect(ctx, op, path, b, a); len(errs) != 0 {
+		t.Errorf("expected EqualTo to accept equal-canonical values despite differing Raw, got %v", errs)
+	}
+
+	changed := fakeQuantity{Canonical: 2048, Raw: "2Ki"}
+	if errs := ImmutableByReflect(ctx, op, path, changed, a); len(errs) == 0 {
+		t.Errorf("expected EqualTo to reject differing-canonical values, got none")
+	}
+}
+
 func TestImmutableValueByCompare(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -628,3 +735,38 @@ func TestImmutableVariantsConsistency(t *testing.T) {
 		})
 	}
 }
+
+func TestSamePointerIdentity(t *testing.T) {
+	s := []string{"a", "b"}
+	m := map[string]string{"a": "b"}
+	p := ptr.To(1)
+
+	for _, tc := range []struct {
+		name          string
+		value, oldVal interface{}
+		wantIdentical bool
+	}{
+		{"same slice header", s, s, true},
+		{"equal but distinct slices", []string{"a", "b"}, append([]string{}, "a", "b"), false},
+		{"nil slice vs nil slice", []string(nil), []string(nil), true},
+		{"nil slice vs empty slice", []string(nil), []string{}, false},
+		{"same map", m, m, true},
+		{"equal but distinct maps", map[string]string{"a": "b"}, map[string]string{"a": "b"}, false},
+		{"nil map vs nil map", map[string]string(nil), map[string]string(nil), true},
+		{"nil map vs empty map", map[string]string(nil), map[string]string{}, false},
+		{"same pointer", p, p, true},
+		{"distinct pointers to equal values", ptr.To(1), ptr.To(1), false},
+		{"scalar", 1, 1, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := samePointerIdentity(tc.value, tc.oldVal); got != tc.wantIdentical {
+				t.Errorf("samePointerIdentity(%v, %v) = %v, want %v", tc.value, tc.oldVal, got, tc.wantIdentical)
+			}
+			// Whenever samePointerIdentity claims identity, reflectEqual must
+			// agree -- the shortcut can never be "more equal" than DeepEqual.
+			if tc.wantIdentical && !reflectEqual(tc.value, tc.oldVal) {
+				t.Errorf("reflectEqual disagreed with samePointerIdentity for %v, %v", tc.value, tc.oldVal)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ImmutableValueAfterByCompare allows value to change freely until
+// oldTrigger -- the old object's value of the sibling field named
+// triggerFieldName -- equals wantTrigger, after which value is compared
+// exactly like ImmutableValueByCompare. The trigger is always read from the
+// old object, so once a prior update has already moved the sibling field to
+// wantTrigger, this field is frozen for this update and all subsequent ones.
+// This matches Kubernetes' common "immutable after X" fields, e.g. a
+// PersistentVolumeClaim's spec once status.phase is Bound.
+func ImmutableValueAfterByCompare[T comparable](ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *T, oldTrigger *string, wantTrigger, triggerFieldName string) field.ErrorList {
+	if !triggered(oldTrigger, wantTrigger) {
+		return nil
+	}
+	return immutableByCompareCheck(op, fldPath, value, oldValue, isUnsetComparable[T], triggerDetail(triggerFieldName, wantTrigger))
+}
+
+// ImmutablePointerAfterByCompare is ImmutableValueAfterByCompare for pointer
+// fields; see ImmutablePointerByCompare for the nil-is-unset semantics that
+// apply once triggered.
+func ImmutablePointerAfterByCompare[T comparable](ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *T, oldTrigger *string, wantTrigger, triggerFieldName string) field.ErrorList {
+	if !triggered(oldTrigger, wantTrigger) {
+		return nil
+	}
+	return immutableByCompareCheck(op, fldPath, value, oldValue, func(v *T) bool { return v == nil }, triggerDetail(triggerFieldName, wantTrigger))
+}
+
+// ImmutableAfterByReflect is ImmutableValueAfterByCompare for types that are
+// not directly comparable; see ImmutableByReflect for the semantics that
+// apply once triggered.
+func ImmutableAfterByReflect[T any](ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T, oldTrigger *string, wantTrigger, triggerFieldName string) field.ErrorList {
+	if !triggered(oldTrigger, wantTrigger) {
+		return nil
+	}
+	return immutableByReflectCheck(op, fldPath, value, oldValue, triggerDetail(triggerFieldName, wantTrigger))
+}
+
+// triggered reports whether an immutableAfter field should be frozen for
+// this update, i.e. whether the old object's trigger field has already
+// reached wantTrigger. A nil oldTrigger (the sibling field absent from the
+// old object, e.g. it is itself a nil pointer) never triggers.
+func triggered(oldTrigger *string, wantTrigger string) bool {
+	return oldTrigger != nil && *oldTrigger == wantTrigger
+}
+
+func triggerDetail(triggerFieldName, wantTrigger string) string {
+	return fmt.Sprintf(" (%s is %q)", triggerFieldName, wantTrigger)
+}
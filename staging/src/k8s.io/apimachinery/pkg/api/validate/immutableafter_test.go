@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestImmutableValueAfterByCompare(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		value      *int
+		oldValue   *int
+		oldTrigger *string
+		fail       bool
+	}{{
+		name:       "not yet triggered, modify allowed",
+		value:      ptr.To(456),
+		oldValue:   ptr.To(123),
+		oldTrigger: ptr.To("Pending"),
+	}, {
+		name:       "not yet triggered, trigger field unset",
+		value:      ptr.To(456),
+		oldValue:   ptr.To(123),
+		oldTrigger: nil,
+	}, {
+		name:       "triggered, same value",
+		value:      ptr.To(123),
+		oldValue:   ptr.To(123),
+		oldTrigger: ptr.To("Bound"),
+	}, {
+		name:       "triggered, unset to set",
+		value:      ptr.To(123),
+		oldValue:   ptr.To(0),
+		oldTrigger: ptr.To("Bound"),
+	}, {
+		name:       "triggered, modify forbidden",
+		value:      ptr.To(456),
+		oldValue:   ptr.To(123),
+		oldTrigger: ptr.To("Bound"),
+		fail:       true,
+	}, {
+		name:       "triggered, clear forbidden",
+		value:      ptr.To(0),
+		oldValue:   ptr.To(123),
+		oldTrigger: ptr.To("Bound"),
+		fail:       true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ImmutableValueAfterByCompare(context.Background(), operation.Operation{Type: operation.Create}, field.NewPath(""), tc.value, tc.oldValue, tc.oldTrigger, "Bound", "status.phase")
+			if len(errs) != 0 {
+				t.Errorf("create: expected success: %v", errs)
+			}
+			errs = ImmutableValueAfterByCompare(context.Background(), operation.Operation{Type: operation.Update}, field.NewPath(""), tc.value, tc.oldValue, tc.oldTrigger, "Bound", "status.phase")
+			if tc.fail && len(errs) == 0 {
+				t.Errorf("update: expected failure")
+			} else if !tc.fail && len(errs) != 0 {
+				t.Errorf("update: expected success: %v", errs)
+			}
+		})
+	}
+}
+
+func TestImmutablePointerAfterByCompare(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		value      *int
+		oldValue   *int
+		oldTrigger *string
+		fail       bool
+	}{{
+		name:       "not yet triggered, clear allowed",
+		value:      nil,
+		oldValue:   ptr.To(123),
+		oldTrigger: ptr.To("Pending"),
+	}, {
+		name:       "triggered, clear forbidden",
+		value:      nil,
+		oldValue:   ptr.To(123),
+		oldTrigger: ptr.To("Bound"),
+		fail:       true,
+	}, {
+		name:       "triggered, unset to set allowed",
+		value:      ptr.To(123),
+		oldValue:   nil,
+		oldTrigger: ptr.To("Bound"),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ImmutablePointerAfterByCompare(context.Background(), operation.Operation{Type: operation.Update}, field.NewPath(""), tc.value, tc.oldValue, tc.oldTrigger, "Bound", "status.phase")
+			if tc.fail && len(errs) == 0 {
+				t.Errorf("expected failure")
+			} else if !tc.fail && len(errs) != 0 {
+				t.Errorf("expected success: %v", errs)
+			}
+		})
+	}
+}
+
+func TestImmutableAfterByReflect(t *testing.T) {
+	structA := StructNonComparable{S: "abc", I: 123}
+	structB := StructNonComparable{S: "xyz", I: 456}
+
+	for _, tc := range []struct {
+		name       string
+		value      StructNonComparable
+		oldValue   StructNonComparable
+		oldTrigger *string
+		fail       bool
+	}{{
+		name:       "not yet triggered, modify allowed",
+		value:      structB,
+		oldValue:   structA,
+		oldTrigger: ptr.To("Pending"),
+	}, {
+		name:       "triggered, modify forbidden",
+		value:      structB,
+		oldValue:   structA,
+		oldTrigger: ptr.To("Bound"),
+		fail:       true,
+	}, {
+		name:       "triggered, same value",
+		value:      structA,
+		oldValue:   structA,
+		oldTrigger: ptr.To("Bound"),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ImmutableAfterByReflect(context.Background(), operation.Operation{Type: operation.Update}, field.NewPath(""), tc.value, tc.oldValue, tc.oldTrigger, "Bound", "status.phase")
+			if tc.fail && len(errs) == 0 {
+				t.Errorf("expected failure")
+			} else if !tc.fail && len(errs) != 0 {
+				t.Errorf("expected success: %v", errs)
+			}
+		})
+	}
+}
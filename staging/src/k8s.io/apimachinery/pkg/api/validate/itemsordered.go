@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ItemsOrderedBy validates that list is ordered according to less (which
+// should report whether its first argument belongs strictly before its
+// second), reporting a field.Invalid error at the first index found out of
+// order. It does not compare list to oldList: re-ordering on update is not
+// itself a violation, only the new list's own ordering is checked.
+func ItemsOrderedBy[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, list, _ []T, less func(a, b *T) bool) field.ErrorList {
+	for i := 1; i < len(list); i++ {
+		if less(&list[i], &list[i-1]) {
+			return field.ErrorList{field.Invalid(fldPath.Index(i), list[i], "must be ordered relative to the preceding item").WithOrigin("k8s:itemsOrderedBy")}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestItemsOrderedBy(t *testing.T) {
+	path := field.NewPath("test")
+	less := func(a, b *int) bool { return *a < *b }
+
+	if errs := ItemsOrderedBy(context.Background(), operation.Operation{}, path, []int{1, 2, 3}, nil, less); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	errs := ItemsOrderedBy(context.Background(), operation.Operation{}, path, []int{1, 3, 2}, nil, less)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if want := "test[2]"; errs[0].Field != want {
+		t.Errorf("expected error at %q, got %q", want, errs[0].Field)
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ListMapElementByJSONPath is ListMapElementByKey generalized to a JSONPath-
+// like expression, for addressing an element nested more deeply than a
+// single top-level key/value pair -- e.g.
+// `status.conditions[?(@.type=="Ready")].reason`. expr is walked segment by
+// segment against newObj's and oldObj's reflect.Value independently (so a
+// field added or removed between the two is handled the same way
+// ListMapElementByKey handles a missing list element), and elementValidator
+// is invoked with whichever side(s) resolved to a value.
+//
+// The supported expr subset is intentionally small:
+//   - dotted field/map-key segments, e.g. "status.conditions"
+//   - a numeric index selector, e.g. "[0]"
+//   - a single-predicate filter selector over a slice of structs, e.g.
+//     `[?(@.type=="Ready")]`, matching the first element whose named field
+//     equals the quoted value
+//
+// This is not a general JSONPath implementation (no unions, recursive
+// descent, or wildcard selectors) -- just enough to reach one nested field
+// inside a list-map element without a generated accessor.
+func ListMapElementByJSONPath[TStruct any, TField any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newObj, oldObj *TStruct,
+	expr string,
+	elementValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newVal, oldVal *TField) field.ErrorList,
+) field.ErrorList {
+	segments, err := parseJSONPath(expr)
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldPath, fmt.Errorf("%s: %w", expr, err))}
+	}
+
+	var newVal, oldVal *TField
+	path := fldPath
+
+	if newObj != nil {
+		if v, p, ok := resolveJSONPath(reflect.ValueOf(newObj).Elem(), fldPath, segments); ok {
+			if tv, ok := v.Interface().(TField); ok {
+				newVal = &tv
+				path = p
+			}
+		}
+	}
+	if oldObj != nil {
+		if v, p, ok := resolveJSONPath(reflect.ValueOf(oldObj).Elem(), fldPath, segments); ok {
+			if tv, ok := v.Interface().(TField); ok {
+				oldVal = &tv
+				if newVal == nil {
+					path = p
+				}
+			}
+		}
+	}
+
+	if newVal == nil && oldVal == nil {
+		return nil
+	}
+	return elementValidator(ctx, op, path, newVal, oldVal)
+}
+
+// jsonPathSegment is one dotted component of a parsed expr, optionally
+// followed by an index or filter selector.
+type jsonPathSegment struct {
+	name string
+
+	hasIndex bool
+	index    int
+
+	hasFilter  bool
+	filterKey  string
+	filterWant string
+}
+
+var jsonPathSegmentRE = regexp.MustCompile(`^([A-Za-z0-9_]+)(?:\[(?:(\d+)|\?\(@\.([A-Za-z0-9_]+)==(.+)\))\])?$`)
+
+// splitJSONPathSegments splits expr on '.' the way parseJSONPath needs: a
+// dot inside a filter's `[?(@.field==...)]` selector (there to address the
+// predicate's own field) must not split the segment, so this tracks
+// bracket depth rather than using a plain strings.Split.
+func splitJSONPathSegments(expr string) []string {
+	var out []string
+	depth := 0
+	start := 0
+	for i, r := range expr {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				out = append(out, expr[start:i])
+				start = i + 1
+			}
+		}
+	}
+	out = append(out, expr[start:])
+	return out
+}
+
+// parseJSONPath splits expr (e.g. `status.conditions[?(@.type=="Ready")].reason`)
+// into its dotted segments.
+func parseJSONPath(expr string) ([]jsonPathSegment, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("empty expression")
+	}
+	var out []jsonPathSegment
+	for _, part := range splitJSONPathSegments(expr) {
+		m := jsonPathSegmentRE.FindStringSubmatch(part)
+		if m == nil {
+			return nil, fmt.Errorf("invalid segment %q", part)
+		}
+		seg := jsonPathSegment{name: m[1]}
+		switch {
+		case m[2] != "":
+			idx, err := strconv.Atoi(m[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index in segment %q: %w", part, err)
+			}
+			seg.hasIndex = true
+			seg.index = idx
+		case m[3] != "":
+			seg.hasFilter = true
+			seg.filterKey = m[3]
+			seg.filterWant = strings.Trim(m[4], `"'`)
+		}
+		out = append(out, seg)
+	}
+	return out, nil
+}
+
+// resolveJSONPath walks root (a struct) through segments, returning the
+// resolved reflect.Value, the field.Path it corresponds to (rooted at
+// fldPath), and whether resolution succeeded. It mirrors
+// ListMapElementByKey's FormatListMapKey convention for filter selectors so
+// the two report comparable paths.
+func resolveJSONPath(root reflect.Value, fldPath *field.Path, segments []jsonPathSegment) (reflect.Value, *field.Path, bool) {
+	v := root
+	path := fldPath
+	for _, seg := range segments {
+		v = derefSemantic(v)
+		if !v.IsValid() || v.Kind() != reflect.Struct {
+			return reflect.Value{}, nil, false
+		}
+		fv, ok := fieldByJSONName(v, seg.name)
+		if !ok {
+			return reflect.Value{}, nil, false
+		}
+		path = path.Child(seg.name)
+		v = fv
+
+		switch {
+		case seg.hasIndex:
+			v = derefSemantic(v)
+			if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) {
+				return reflect.Value{}, nil, false
+			}
+			if seg.index < 0 || seg.index >= v.Len() {
+				return reflect.Value{}, nil, false
+			}
+			path = path.Index(seg.index)
+			v = v.Index(seg.index)
+		case seg.hasFilter:
+			v = derefSemantic(v)
+			if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) {
+				return reflect.Value{}, nil, false
+			}
+			found := false
+			for i := 0; i < v.Len(); i++ {
+				elem := derefSemantic(v.Index(i))
+				if !elem.IsValid() || elem.Kind() != reflect.Struct {
+					continue
+				}
+				got, ok := getReflectedJSONFieldValueAsString(elem, seg.filterKey)
+				if ok && got == seg.filterWant {
+					path = path.Key(FormatListMapKey(seg.filterKey, seg.filterWant))
+					v = v.Index(i)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return reflect.Value{}, nil, false
+			}
+		}
+	}
+	v = derefSemantic(v)
+	if !v.IsValid() {
+		return reflect.Value{}, nil, false
+	}
+	return v, path, true
+}
+
+// fieldByJSONName looks up sVal's field named jsonName in its json tags,
+// reusing the same name-resolution rules as getReflectedJSONFieldValueAsString.
+func fieldByJSONName(sVal reflect.Value, jsonName string) (reflect.Value, bool) {
+	typ := sVal.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+		tag := f.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		if name == jsonName {
+			return sVal.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type jpCondition struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type jpStatus struct {
+	Conditions []jpCondition `json:"conditions"`
+}
+
+type jpObj struct {
+	Status jpStatus `json:"status"`
+}
+
+func TestListMapElementByJSONPath(t *testing.T) {
+	path := field.NewPath("test")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	newObj := &jpObj{Status: jpStatus{Conditions: []jpCondition{
+		{Type: "Available", Reason: "fine"},
+		{Type: "Ready", Reason: "new-reason"},
+	}}}
+	oldObj := &jpObj{Status: jpStatus{Conditions: []jpCondition{
+		{Type: "Ready", Reason: "old-reason"},
+		{Type: "Available", Reason: "fine"},
+	}}}
+
+	var gotNew, gotOld *string
+	errs := ListMapElementByJSONPath[jpObj, string](ctx, op, path, newObj, oldObj,
+		`status.conditions[?(@.type=="Ready")].reason`,
+		func(_ context.Context, _ operation.Operation, _ *field.Path, newVal, oldVal *string) field.ErrorList {
+			gotNew, gotOld = newVal, oldVal
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if gotNew == nil || *gotNew != "new-reason" {
+		t.Errorf("expected new reason %q, got %v", "new-reason", gotNew)
+	}
+	if gotOld == nil || *gotOld != "old-reason" {
+		t.Errorf("expected old reason %q, got %v", "old-reason", gotOld)
+	}
+}
+
+func TestListMapElementByJSONPathNoMatch(t *testing.T) {
+	path := field.NewPath("test")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	obj := &jpObj{Status: jpStatus{Conditions: []jpCondition{{Type: "Available", Reason: "fine"}}}}
+
+	called := false
+	errs := ListMapElementByJSONPath[jpObj, string](ctx, op, path, obj, obj,
+		`status.conditions[?(@.type=="Ready")].reason`,
+		func(_ context.Context, _ operation.Operation, _ *field.Path, newVal, oldVal *string) field.ErrorList {
+			called = true
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if called {
+		t.Errorf("expected elementValidator not to be called when nothing matches")
+	}
+}
+
+func TestListMapElementByJSONPathIndex(t *testing.T) {
+	path := field.NewPath("test")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	obj := &jpObj{Status: jpStatus{Conditions: []jpCondition{
+		{Type: "Available", Reason: "first"},
+		{Type: "Ready", Reason: "second"},
+	}}}
+
+	var got *string
+	errs := ListMapElementByJSONPath[jpObj, string](ctx, op, path, obj, nil,
+		`status.conditions[1].reason`,
+		func(_ context.Context, _ operation.Operation, _ *field.Path, newVal, oldVal *string) field.ErrorList {
+			got = newVal
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if got == nil || *got != "second" {
+		t.Errorf("expected %q, got %v", "second", got)
+	}
+}
+
+func TestListMapElementByJSONPathInvalidExpr(t *testing.T) {
+	path := field.NewPath("test")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+	obj := &jpObj{}
+
+	errs := ListMapElementByJSONPath[jpObj, string](ctx, op, path, obj, nil, `status..conditions`,
+		func(_ context.Context, _ operation.Operation, _ *field.Path, newVal, oldVal *string) field.ErrorList {
+			return nil
+		})
+	if len(errs) == 0 {
+		t.Errorf("expected an error for an invalid expression, got none")
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	segs, err := parseJSONPath(`status.conditions[?(@.type=="Ready")].reason`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(segs) != 3 {
+		t.Fatalf("expected 3 segments, got %d: %+v", len(segs), segs)
+	}
+	if segs[1].name != "conditions" || !segs[1].hasFilter || segs[1].filterKey != "type" || segs[1].filterWant != "Ready" {
+		t.Errorf("unexpected filter segment: %+v", segs[1])
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// multipleOfFloatTolerance absorbs floating point rounding when checking
+// whether a float or resource.Quantity value is an exact multiple of a
+// factor: the quotient only needs to be within this distance of the nearest
+// integer, not bit-for-bit exact.
+const multipleOfFloatTolerance = 1e-9
+
+// integer is the set of Go kinds k8s:minimum, k8s:maximum, and
+// k8s:multipleOf treat as integers.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// float is the set of Go kinds k8s:minimum, k8s:maximum, and k8s:multipleOf
+// treat as floats.
+type float interface {
+	~float32 | ~float64
+}
+
+// Minimum validates that value is greater than or equal to min.
+func Minimum[T integer](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, min T) field.ErrorList {
+	if value == nil || *value >= min {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be greater than or equal to %v", min)).WithOrigin("k8s:minimum")}
+}
+
+// Maximum validates that value is less than or equal to max.
+func Maximum[T integer](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, max T) field.ErrorList {
+	if value == nil || *value <= max {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be less than or equal to %v", max)).WithOrigin("k8s:maximum")}
+}
+
+// ExclusiveMinimum validates that value is strictly greater than min.
+func ExclusiveMinimum[T integer](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, min T) field.ErrorList {
+	if value == nil || *value > min {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be greater than %v", min)).WithOrigin("k8s:exclusiveMinimum")}
+}
+
+// ExclusiveMaximum validates that value is strictly less than max.
+func ExclusiveMaximum[T integer](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, max T) field.ErrorList {
+	if value == nil || *value < max {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be less than %v", max)).WithOrigin("k8s:exclusiveMaximum")}
+}
+
+// MinimumFloat validates that value is greater than or equal to min.
+func MinimumFloat[T float](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, min T) field.ErrorList {
+	if value == nil || *value >= min {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be greater than or equal to %v", min)).WithOrigin("k8s:minimum")}
+}
+
+// MaximumFloat validates that value is less than or equal to max.
+func MaximumFloat[T float](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, max T) field.ErrorList {
+	if value == nil || *value <= max {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be less than or equal to %v", max)).WithOrigin("k8s:maximum")}
+}
+
+// ExclusiveMinimumFloat validates that value is strictly greater than min.
+func ExclusiveMinimumFloat[T float](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, min T) field.ErrorList {
+	if value == nil || *value > min {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be greater than %v", min)).WithOrigin("k8s:exclusiveMinimum")}
+}
+
+// ExclusiveMaximumFloat validates that value is strictly less than max.
+func ExclusiveMaximumFloat[T float](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, max T) field.ErrorList {
+	if value == nil || *value < max {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be less than %v", max)).WithOrigin("k8s:exclusiveMaximum")}
+}
+
+// MinimumQuantity validates that value is greater than or equal to min.
+func MinimumQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *resource.Quantity, min float64) field.ErrorList {
+	if value == nil || value.AsApproximateFloat64() >= min {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be greater than or equal to %v", min)).WithOrigin("k8s:minimum")}
+}
+
+// MaximumQuantity validates that value is less than or equal to max.
+func MaximumQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *resource.Quantity, max float64) field.ErrorList {
+	if value == nil || value.AsApproximateFloat64() <= max {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be less than or equal to %v", max)).WithOrigin("k8s:maximum")}
+}
+
+// ExclusiveMinimumQuantity validates that value is strictly greater than min.
+func ExclusiveMinimumQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *resource.Quantity, min float64) field.ErrorList {
+	if value == nil || value.AsApproximateFloat64() > min {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be greater than %v", min)).WithOrigin("k8s:exclusiveMinimum")}
+}
+
+// ExclusiveMaximumQuantity validates that value is strictly less than max.
+func ExclusiveMaximumQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *resource.Quantity, max float64) field.ErrorList {
+	if value == nil || value.AsApproximateFloat64() < max {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be less than %v", max)).WithOrigin("k8s:exclusiveMaximum")}
+}
+
+// MultipleOf validates that value is an exact integer multiple of factor.
+func MultipleOf[T integer](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, factor T) field.ErrorList {
+	if value == nil || factor == 0 || *value%factor == 0 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be a multiple of %v", factor)).WithOrigin("k8s:multipleOf")}
+}
+
+// MultipleOfFloat validates that value is a multiple of factor, within
+// multipleOfFloatTolerance to absorb floating point rounding.
+func MultipleOfFloat[T float](_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *T, factor T) field.ErrorList {
+	if value == nil || factor == 0 || isMultiple(float64(*value), float64(factor)) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be a multiple of %v", factor)).WithOrigin("k8s:multipleOf")}
+}
+
+// MultipleOfQuantity validates that value is a multiple of factor, within
+// multipleOfFloatTolerance to absorb floating point rounding.
+func MultipleOfQuantity(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *resource.Quantity, factor float64) field.ErrorList {
+	if value == nil || factor == 0 || isMultiple(value.AsApproximateFloat64(), factor) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be a multiple of %v", factor)).WithOrigin("k8s:multipleOf")}
+}
+
+// isMultiple reports whether v/factor is within multipleOfFloatTolerance of
+// a whole number.
+func isMultiple(v, factor float64) bool {
+	quotient := v / factor
+	return math.Abs(quotient-math.Round(quotient)) < multipleOfFloatTolerance
+}
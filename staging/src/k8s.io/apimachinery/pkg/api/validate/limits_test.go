@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestMinimum(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 5
+	if errs := Minimum(context.Background(), operation.Operation{}, path, &val, nil, 5); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 4
+	if errs := Minimum(context.Background(), operation.Operation{}, path, &bad, nil, 5); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if errs := Minimum[int](context.Background(), operation.Operation{}, path, nil, nil, 5); len(errs) != 0 {
+		t.Errorf("expected no errors for nil value, got %s", fmtErrs(errs))
+	}
+}
+
+func TestMaximum(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 5
+	if errs := Maximum(context.Background(), operation.Operation{}, path, &val, nil, 5); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 6
+	if errs := Maximum(context.Background(), operation.Operation{}, path, &bad, nil, 5); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestExclusiveMinimum(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 6
+	if errs := ExclusiveMinimum(context.Background(), operation.Operation{}, path, &val, nil, 5); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 5
+	if errs := ExclusiveMinimum(context.Background(), operation.Operation{}, path, &bad, nil, 5); len(errs) != 1 {
+		t.Errorf("expected 1 error for a value equal to the exclusive bound, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestExclusiveMaximum(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 4
+	if errs := ExclusiveMaximum(context.Background(), operation.Operation{}, path, &val, nil, 5); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 5
+	if errs := ExclusiveMaximum(context.Background(), operation.Operation{}, path, &bad, nil, 5); len(errs) != 1 {
+		t.Errorf("expected 1 error for a value equal to the exclusive bound, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMinimumFloat(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 1.5
+	if errs := MinimumFloat(context.Background(), operation.Operation{}, path, &val, nil, 1.5); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 1.4
+	if errs := MinimumFloat(context.Background(), operation.Operation{}, path, &bad, nil, 1.5); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMaximumFloat(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 1.5
+	if errs := MaximumFloat(context.Background(), operation.Operation{}, path, &val, nil, 1.5); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 1.6
+	if errs := MaximumFloat(context.Background(), operation.Operation{}, path, &bad, nil, 1.5); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMinimumQuantity(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := resource.MustParse("2")
+	if errs := MinimumQuantity(context.Background(), operation.Operation{}, path, &val, nil, 1); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := resource.MustParse("500m")
+	if errs := MinimumQuantity(context.Background(), operation.Operation{}, path, &bad, nil, 1); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMaximumQuantity(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := resource.MustParse("500m")
+	if errs := MaximumQuantity(context.Background(), operation.Operation{}, path, &val, nil, 1); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := resource.MustParse("2")
+	if errs := MaximumQuantity(context.Background(), operation.Operation{}, path, &bad, nil, 1); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMultipleOf(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 9
+	if errs := MultipleOf(context.Background(), operation.Operation{}, path, &val, nil, 3); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 10
+	if errs := MultipleOf(context.Background(), operation.Operation{}, path, &bad, nil, 3); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestMultipleOfFloat(t *testing.T) {
+	path := field.NewPath("test")
+
+	val := 0.9
+	if errs := MultipleOfFloat(context.Background(), operation.Operation{}, path, &val, nil, 0.3); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	bad := 1.0
+	if errs := MultipleOfFloat(context.Background(), operation.Operation{}, path, &bad, nil, 0.3); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
@@ -18,6 +18,7 @@ package validate
 
 import (
 	"context"
+	"sort"
 
 	"k8s.io/apimachinery/pkg/api/operation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -65,3 +66,173 @@ func ListMapItemByKeyValues[TList ~[]TItem, TItem any](
 	}
 	return itemValidator(ctx, op, path, matchedNew, matchedOld)
 }
+
+// ListMapItemsByKeyValues generalizes ListMapItemByKeyValues to every item the
+// 'matches' predicate selects, instead of only the first. It collects every
+// matching item in newList and in oldList (in list order), pairs the 1st new
+// match with the 1st old match, the 2nd with the 2nd, and so on, and invokes
+// itemValidator once per pair -- so ratcheting still compares a sensible
+// new/old pair even when the list contains transient duplicates of the
+// intended key. A pair with no new match (only present in oldList) is a
+// delete and gets a nil new pointer; a pair with no old match (only present
+// in newList) is an add and gets a nil old pointer.
+// If 'matches' selects more than one item in either list, every match past
+// the first in that list also gets a field.Duplicate error at its index, so
+// a broken primary-key assumption surfaces as a validation error instead of
+// silently mispairing items.
+func ListMapItemsByKeyValues[TList ~[]TItem, TItem any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newList, oldList TList,
+	matches MatchFn[TItem],
+	itemValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *TItem) field.ErrorList,
+) field.ErrorList {
+	var errs field.ErrorList
+
+	var newMatches, oldMatches []int
+	for i := range newList {
+		if matches(&newList[i]) {
+			newMatches = append(newMatches, i)
+		}
+	}
+	for i := range oldList {
+		if matches(&oldList[i]) {
+			oldMatches = append(oldMatches, i)
+		}
+	}
+
+	for k, i := range newMatches {
+		if k > 0 {
+			errs = append(errs, field.Duplicate(fldPath.Index(i), newList[i]))
+		}
+	}
+	for k, i := range oldMatches {
+		if k > 0 {
+			errs = append(errs, field.Duplicate(fldPath.Index(i), oldList[i]))
+		}
+	}
+
+	pairs := len(newMatches)
+	if len(oldMatches) > pairs {
+		pairs = len(oldMatches)
+	}
+	for k := 0; k < pairs; k++ {
+		var newItem, oldItem *TItem
+		path := fldPath
+		if k < len(newMatches) {
+			idx := newMatches[k]
+			newItem = &newList[idx]
+			path = fldPath.Index(idx)
+		}
+		if k < len(oldMatches) {
+			idx := oldMatches[k]
+			oldItem = &oldList[idx]
+			if newItem == nil {
+				path = fldPath.Index(idx)
+			}
+		}
+		errs = append(errs, itemValidator(ctx, op, path, newItem, oldItem)...)
+	}
+	return errs
+}
+
+// ListMapItemsByPredicate validates every element that 'matches' selects, in
+// both newList and oldList, without ListMapItemsByKeyValues' assumption that
+// 'matches' identifies a unique element: a predicate like "name is not
+// istio-proxy" or "protocol is TCP or SCTP" may legitimately match many
+// elements, so there is no well-defined single "the" match to report as a
+// field.Duplicate. Matches are instead paired by list index: for each index
+// i, itemValidator is called if newList[i] or oldList[i] (or both) satisfy
+// 'matches', with a nil pointer for whichever side doesn't.
+func ListMapItemsByPredicate[TList ~[]TItem, TItem any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newList, oldList TList,
+	matches MatchFn[TItem],
+	itemValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *TItem) field.ErrorList,
+) field.ErrorList {
+	var errs field.ErrorList
+
+	n := len(newList)
+	if len(oldList) > n {
+		n = len(oldList)
+	}
+	for i := 0; i < n; i++ {
+		var newItem, oldItem *TItem
+		if i < len(newList) && matches(&newList[i]) {
+			newItem = &newList[i]
+		}
+		if i < len(oldList) && matches(&oldList[i]) {
+			oldItem = &oldList[i]
+		}
+		if newItem == nil && oldItem == nil {
+			continue
+		}
+		errs = append(errs, itemValidator(ctx, op, fldPath.Index(i), newItem, oldItem)...)
+	}
+	return errs
+}
+
+// ListMapItemsByPartialKeyValues backs +k8s:listMapItems, which -- unlike
+// +k8s:listMapItem -- lets 'matches' check only a subset of a list-map's
+// declared keys, so it may legitimately select many items of the list at
+// once. Matched items are paired between newList and oldList by
+// keyPath(item) (which renders the item's full set of +k8s:listMapKey
+// values, not just the fields 'matches' checked), rather than by list
+// index, so ratcheting still lines up the right new/old pair even when
+// matches are found in a different relative order in the two lists.
+// itemValidator is invoked once per distinct keyPath value that matches in
+// either list, with a nil pointer for whichever side has no matching item
+// at that key. Two matched items in the same list reporting the same
+// keyPath (a broken list-map-key assumption) is reported as a
+// field.Duplicate at the later item's path instead of silently discarding
+// one of them.
+func ListMapItemsByPartialKeyValues[TList ~[]TItem, TItem any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newList, oldList TList,
+	matches MatchFn[TItem],
+	keyPath func(*TItem) string,
+	itemValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *TItem) field.ErrorList,
+) field.ErrorList {
+	var errs field.ErrorList
+
+	oldByKey := map[string]*TItem{}
+	for i := range oldList {
+		if !matches(&oldList[i]) {
+			continue
+		}
+		key := keyPath(&oldList[i])
+		if _, dup := oldByKey[key]; dup {
+			errs = append(errs, field.Duplicate(fldPath.Key(key), oldList[i]))
+			continue
+		}
+		oldByKey[key] = &oldList[i]
+	}
+
+	seen := map[string]bool{}
+	for i := range newList {
+		if !matches(&newList[i]) {
+			continue
+		}
+		newItem := &newList[i]
+		key := keyPath(newItem)
+		path := fldPath.Key(key)
+		if seen[key] {
+			errs = append(errs, field.Duplicate(path, *newItem))
+			continue
+		}
+		seen[key] = true
+		errs = append(errs, itemValidator(ctx, op, path, newItem, oldByKey[key])...)
+	}
+
+	var deletedKeys []string
+	for key := range oldByKey {
+		if !seen[key] {
+			deletedKeys = append(deletedKeys, key)
+		}
+	}
+	sort.Strings(deletedKeys)
+	for _, key := range deletedKeys {
+		errs = append(errs, itemValidator(ctx, op, fldPath.Key(key), nil, oldByKey[key])...)
+	}
+
+	return errs
+}
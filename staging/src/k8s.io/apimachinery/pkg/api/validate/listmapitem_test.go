@@ -0,0 +1,313 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type lmiItem struct {
+	Name string
+	Data string
+}
+
+func lmiMatchesName(name string) MatchFn[lmiItem] {
+	return func(item *lmiItem) bool { return item != nil && item.Name == name }
+}
+
+func TestListMapItemsByKeyValues(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	type call struct {
+		path string
+		new  *lmiItem
+		old  *lmiItem
+	}
+
+	cases := []struct {
+		name     string
+		newList  []lmiItem
+		oldList  []lmiItem
+		wantCall []call
+		wantDups []string
+	}{{
+		name:    "single pair, no duplicates",
+		newList: []lmiItem{{Name: "a", Data: "new"}},
+		oldList: []lmiItem{{Name: "a", Data: "old"}},
+		wantCall: []call{
+			{path: "items[0]", new: &lmiItem{Name: "a", Data: "new"}, old: &lmiItem{Name: "a", Data: "old"}},
+		},
+	}, {
+		name:    "add: only a new match",
+		newList: []lmiItem{{Name: "a", Data: "new"}},
+		oldList: nil,
+		wantCall: []call{
+			{path: "items[0]", new: &lmiItem{Name: "a", Data: "new"}, old: nil},
+		},
+	}, {
+		name:    "delete: only an old match",
+		newList: nil,
+		oldList: []lmiItem{{Name: "a", Data: "old"}},
+		wantCall: []call{
+			{path: "items[0]", new: nil, old: &lmiItem{Name: "a", Data: "old"}},
+		},
+	}, {
+		name:    "transient duplicates pair up in order",
+		newList: []lmiItem{{Name: "a", Data: "new1"}, {Name: "a", Data: "new2"}},
+		oldList: []lmiItem{{Name: "a", Data: "old1"}, {Name: "a", Data: "old2"}},
+		wantCall: []call{
+			{path: "items[0]", new: &lmiItem{Name: "a", Data: "new1"}, old: &lmiItem{Name: "a", Data: "old1"}},
+			{path: "items[1]", new: &lmiItem{Name: "a", Data: "new2"}, old: &lmiItem{Name: "a", Data: "old2"}},
+		},
+		wantDups: []string{"items[1]", "items[1]"},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []call
+			errs := ListMapItemsByKeyValues(ctx, op, path, tc.newList, tc.oldList, lmiMatchesName("a"),
+				func(_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, oldObj *lmiItem) field.ErrorList {
+					calls = append(calls, call{path: fldPath.String(), new: newObj, old: oldObj})
+					return nil
+				})
+
+			var gotDups []string
+			for _, e := range errs {
+				gotDups = append(gotDups, e.Field)
+			}
+			if len(gotDups) != len(tc.wantDups) {
+				t.Fatalf("expected %d duplicate errors, got %d: %v", len(tc.wantDups), len(gotDups), errs)
+			}
+			for i := range tc.wantDups {
+				if gotDups[i] != tc.wantDups[i] {
+					t.Errorf("duplicate error %d: expected field %q, got %q", i, tc.wantDups[i], gotDups[i])
+				}
+			}
+
+			if len(calls) != len(tc.wantCall) {
+				t.Fatalf("expected %d itemValidator calls, got %d: %v", len(tc.wantCall), len(calls), calls)
+			}
+			for i, want := range tc.wantCall {
+				got := calls[i]
+				if got.path != want.path {
+					t.Errorf("call %d: expected path %q, got %q", i, want.path, got.path)
+				}
+				if (got.new == nil) != (want.new == nil) || (got.new != nil && *got.new != *want.new) {
+					t.Errorf("call %d: expected new %v, got %v", i, want.new, got.new)
+				}
+				if (got.old == nil) != (want.old == nil) || (got.old != nil && *got.old != *want.old) {
+					t.Errorf("call %d: expected old %v, got %v", i, want.old, got.old)
+				}
+			}
+		})
+	}
+}
+
+func TestListMapItemsByKeyValuesNoMatch(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	list := []lmiItem{{Name: "a"}}
+	called := false
+	errs := ListMapItemsByKeyValues(ctx, op, path, list, list, lmiMatchesName("b"),
+		func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *lmiItem) field.ErrorList {
+			called = true
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if called {
+		t.Errorf("expected itemValidator not to be called when there is no match")
+	}
+}
+
+func TestListMapItemsByPredicate(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	notB := func(item *lmiItem) bool { return item != nil && item.Name != "b" }
+
+	newList := []lmiItem{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	oldList := []lmiItem{{Name: "a"}, {Name: "x"}}
+
+	type call struct {
+		path string
+		new  *lmiItem
+		old  *lmiItem
+	}
+	var calls []call
+	errs := ListMapItemsByPredicate(ctx, op, path, newList, oldList, notB,
+		func(_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, oldObj *lmiItem) field.ErrorList {
+			calls = append(calls, call{path: fldPath.String(), new: newObj, old: oldObj})
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+
+	want := []call{
+		{path: "items[0]", new: &lmiItem{Name: "a"}, old: &lmiItem{Name: "a"}}, // both match
+		{path: "items[1]", new: nil, old: &lmiItem{Name: "x"}},                 // new[1] is "b", excluded; old[1] "x" matches
+		{path: "items[2]", new: &lmiItem{Name: "c"}, old: nil},                 // new[2] matches, no old[2]
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("expected %d calls, got %d: %v", len(want), len(calls), calls)
+	}
+	for i, w := range want {
+		got := calls[i]
+		if got.path != w.path {
+			t.Errorf("call %d: expected path %q, got %q", i, w.path, got.path)
+		}
+		if (got.new == nil) != (w.new == nil) || (got.new != nil && *got.new != *w.new) {
+			t.Errorf("call %d: expected new %v, got %v", i, w.new, got.new)
+		}
+		if (got.old == nil) != (w.old == nil) || (got.old != nil && *got.old != *w.old) {
+			t.Errorf("call %d: expected old %v, got %v", i, w.old, got.old)
+		}
+	}
+}
+
+func TestListMapItemsByPredicateNoMatch(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	list := []lmiItem{{Name: "a"}}
+	called := false
+	errs := ListMapItemsByPredicate(ctx, op, path, list, list, func(*lmiItem) bool { return false },
+		func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *lmiItem) field.ErrorList {
+			called = true
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if called {
+		t.Errorf("expected itemValidator not to be called when nothing matches")
+	}
+}
+
+func lmiKeyPath(item *lmiItem) string { return FormatListMapKey("Name", item.Name) }
+
+func TestListMapItemsByPartialKeyValues(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	isData := func(data string) MatchFn[lmiItem] {
+		return func(item *lmiItem) bool { return item != nil && item.Data == data }
+	}
+
+	type call struct {
+		path string
+		new  *lmiItem
+		old  *lmiItem
+	}
+
+	t.Run("zero matches", func(t *testing.T) {
+		list := []lmiItem{{Name: "a", Data: "x"}}
+		called := false
+		errs := ListMapItemsByPartialKeyValues(ctx, op, path, list, list, isData("nope"), lmiKeyPath,
+			func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *lmiItem) field.ErrorList {
+				called = true
+				return nil
+			})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		if called {
+			t.Errorf("expected itemValidator not to be called when nothing matches")
+		}
+	})
+
+	t.Run("many matches, paired by full key not index", func(t *testing.T) {
+		// "b" moves position between old and new; pairing must follow the key, not the index.
+		newList := []lmiItem{{Name: "a", Data: "keep"}, {Name: "b", Data: "keep"}, {Name: "c", Data: "keep"}}
+		oldList := []lmiItem{{Name: "b", Data: "keep"}, {Name: "a", Data: "keep"}}
+
+		var calls []call
+		errs := ListMapItemsByPartialKeyValues(ctx, op, path, newList, oldList, isData("keep"), lmiKeyPath,
+			func(_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, oldObj *lmiItem) field.ErrorList {
+				calls = append(calls, call{path: fldPath.String(), new: newObj, old: oldObj})
+				return nil
+			})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+
+		want := map[string]call{
+			"items[Name=a]": {path: "items[Name=a]", new: &lmiItem{Name: "a", Data: "keep"}, old: &lmiItem{Name: "a", Data: "keep"}},
+			"items[Name=b]": {path: "items[Name=b]", new: &lmiItem{Name: "b", Data: "keep"}, old: &lmiItem{Name: "b", Data: "keep"}},
+			"items[Name=c]": {path: "items[Name=c]", new: &lmiItem{Name: "c", Data: "keep"}, old: nil},
+		}
+		if len(calls) != len(want) {
+			t.Fatalf("expected %d calls, got %d: %v", len(want), len(calls), calls)
+		}
+		for _, got := range calls {
+			w, ok := want[got.path]
+			if !ok {
+				t.Fatalf("unexpected call path %q", got.path)
+			}
+			if (got.new == nil) != (w.new == nil) || (got.new != nil && *got.new != *w.new) {
+				t.Errorf("path %s: expected new %v, got %v", got.path, w.new, got.new)
+			}
+			if (got.old == nil) != (w.old == nil) || (got.old != nil && *got.old != *w.old) {
+				t.Errorf("path %s: expected old %v, got %v", got.path, w.old, got.old)
+			}
+		}
+	})
+
+	t.Run("deleted item reports at its key", func(t *testing.T) {
+		oldList := []lmiItem{{Name: "a", Data: "keep"}}
+		var calls []call
+		errs := ListMapItemsByPartialKeyValues(ctx, op, path, nil, oldList, isData("keep"), lmiKeyPath,
+			func(_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, oldObj *lmiItem) field.ErrorList {
+				calls = append(calls, call{path: fldPath.String(), new: newObj, old: oldObj})
+				return nil
+			})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		if len(calls) != 1 || calls[0].path != "items[Name=a]" || calls[0].new != nil || calls[0].old == nil {
+			t.Fatalf("unexpected calls: %v", calls)
+		}
+	})
+
+	t.Run("duplicate key within a list is reported", func(t *testing.T) {
+		newList := []lmiItem{{Name: "a", Data: "keep"}, {Name: "a", Data: "keep"}}
+		var dups []string
+		errs := ListMapItemsByPartialKeyValues(ctx, op, path, newList, nil, isData("keep"), lmiKeyPath,
+			func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *lmiItem) field.ErrorList {
+				return nil
+			})
+		for _, e := range errs {
+			dups = append(dups, e.Field)
+		}
+		if len(dups) != 1 || dups[0] != "items[Name=a]" {
+			t.Fatalf("expected one duplicate error at items[Name=a], got %v", errs)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FormatListMapKey renders a list-map item's key name and value as the
+// canonical "keyName=keyValue" form used inside a field.Path bracket
+// segment, e.g. fldPath.Key(FormatListMapKey("type", "Ready")) produces a
+// path like `conditions[type=Ready]`. Simple values -- ones with no quote,
+// backslash, bracket, "=", ",", or control character, and not empty -- are
+// left bare for backward compatibility with existing paths. Anything else
+// is rendered as a Go-quoted string (strconv.Quote), so ParseListMapKey can
+// recover the exact original value even if it contains quotes, newlines,
+// unicode, or invalid UTF-8 -- unlike JSON quoting, strconv.Quote escapes an
+// invalid byte as "\xHH" instead of silently replacing it with U+FFFD, so
+// the round-trip is exact for every possible string, not just valid UTF-8.
+func FormatListMapKey(keyName, keyValue string) string {
+	return keyName + "=" + formatListMapKeyValue(keyValue)
+}
+
+func formatListMapKeyValue(keyValue string) string {
+	if !isSimpleListMapKeyValue(keyValue) {
+		return strconv.Quote(keyValue)
+	}
+	return keyValue
+}
+
+// isSimpleListMapKeyValue reports whether keyValue can be rendered bare,
+// without quoting, in a list-map path segment.
+func isSimpleListMapKeyValue(keyValue string) bool {
+	if keyValue == "" {
+		return false
+	}
+	for _, r := range keyValue {
+		switch r {
+		case '"', '\\', '[', ']', '=', ',':
+			return false
+		}
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseListMapKey parses s -- the bracket content of a field.Path Key
+// segment produced by FormatListMapKey -- back into its keyName and
+// keyValue. It returns ok=false if s has no "=" separator or its value
+// portion looks quoted but fails to parse.
+func ParseListMapKey(s string) (keyName, keyValue string, ok bool) {
+	i := strings.IndexByte(s, '=')
+	if i < 0 {
+		return "", "", false
+	}
+	keyName, rest := s[:i], s[i+1:]
+	if len(rest) > 0 && rest[0] == '"' {
+		unquoted, err := strconv.Unquote(rest)
+		if err != nil {
+			return "", "", false
+		}
+		return keyName, unquoted, true
+	}
+	return keyName, rest, true
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import "testing"
+
+func TestFormatParseListMapKey(t *testing.T) {
+	cases := []struct {
+		name, value string
+	}{
+		{"type", "Ready"},
+		{"key", ""},
+		{"key", `with"quotes`},
+		{"key", "multi\nline"},
+		{"key", "unicode-🚀"},
+		{"key", "with[bracket]"},
+		{"key", "with=equals"},
+		{"key", "with,comma"},
+		{"key", `with\backslash`},
+	}
+	for _, c := range cases {
+		formatted := FormatListMapKey(c.name, c.value)
+		gotName, gotValue, ok := ParseListMapKey(formatted)
+		if !ok {
+			t.Errorf("ParseListMapKey(%q) returned ok=false", formatted)
+			continue
+		}
+		if gotName != c.name || gotValue != c.value {
+			t.Errorf("FormatListMapKey(%q, %q) -> %q -> Parse = (%q, %q), want (%q, %q)",
+				c.name, c.value, formatted, gotName, gotValue, c.name, c.value)
+		}
+	}
+}
+
+func TestFormatListMapKeySimpleValuesAreBare(t *testing.T) {
+	if got, want := FormatListMapKey("type", "Ready"), "type=Ready"; got != want {
+		t.Errorf("expected simple values to render bare, got %q, want %q", got, want)
+	}
+}
+
+func TestParseListMapKeyNoSeparator(t *testing.T) {
+	if _, _, ok := ParseListMapKey("novalue"); ok {
+		t.Errorf("expected ok=false for a segment with no '=' separator")
+	}
+}
+
+func FuzzFormatParseListMapKey(f *testing.F) {
+	for _, seed := range []string{"", "Ready", `with"quotes`, "multi\nline", "unicode-🚀", "with[bracket]", "with=equals", "with,comma", `with\backslash`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, keyValue string) {
+		formatted := FormatListMapKey("key", keyValue)
+		_, gotValue, ok := ParseListMapKey(formatted)
+		if !ok {
+			t.Fatalf("ParseListMapKey(%q) returned ok=false for FormatListMapKey(%q)", formatted, keyValue)
+		}
+		if gotValue != keyValue {
+			t.Fatalf("round-trip mismatch: FormatListMapKey(%q) = %q, ParseListMapKey -> %q", keyValue, formatted, gotValue)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ListMapElementByKeys is ListMapElementByKey generalized to a typed,
+// possibly composite key: keyFn extracts TKey from a list item (e.g. a
+// generated composite-key struct built from several +k8s:listMapKey fields)
+// instead of ListMapElementByKey's single string key looked up by JSON name
+// via reflection, so a multi-field list-map key can be matched with a single
+// comparable Go value and a map lookup rather than one
+// getReflectedJSONFieldValueAsString call per key field per candidate item.
+// keyValue must implement fmt.Stringer, rendering the canonical
+// "key1=a,key2=b" form generatePathForMap already produces at generation
+// time, so the path reported to elementValidator stays consistent with
+// +k8s:listMapItem's single-key paths (via FormatListMapKey).
+//
+// newList and oldList are each indexed by keyFn into a map[TKey]*TItem once,
+// so a lookup is O(1) regardless of list length; keyFn is expected to be
+// injective over a well-formed list-map. Unlike ListMapItemByKeyValues,
+// which takes the first matching item, if keyFn is not injective the last
+// element in list order with a given key wins.
+func ListMapElementByKeys[TList ~[]TItem, TItem any, TKey interface {
+	comparable
+	String() string
+}](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newList, oldList TList,
+	keyFn func(*TItem) TKey,
+	keyValue TKey,
+	elementValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *TItem) field.ErrorList,
+) field.ErrorList {
+	foundNewElementPtr := lookupByKey(newList, keyFn, keyValue)
+	foundOldElementPtr := lookupByKey(oldList, keyFn, keyValue)
+
+	if foundNewElementPtr == nil && foundOldElementPtr == nil {
+		return nil
+	}
+
+	elementPath := fldPath.Key(keyValue.String())
+	return elementValidator(ctx, op, elementPath, foundNewElementPtr, foundOldElementPtr)
+}
+
+// lookupByKey indexes list by keyFn and returns a pointer to the element
+// matching keyValue, or nil if list is empty or has no such element.
+func lookupByKey[TList ~[]TItem, TItem any, TKey comparable](list TList, keyFn func(*TItem) TKey, keyValue TKey) *TItem {
+	if len(list) == 0 {
+		return nil
+	}
+	byKey := make(map[TKey]*TItem, len(list))
+	for i := range list {
+		byKey[keyFn(&list[i])] = &list[i]
+	}
+	return byKey[keyValue]
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type lmkItem struct {
+	Key1 string
+	Key2 string
+	Data string
+}
+
+// lmkItemKey is the kind of generated composite key a multi-field
+// +k8s:listMapKey would produce.
+type lmkItemKey struct {
+	Key1, Key2 string
+}
+
+func (k lmkItemKey) String() string {
+	return fmt.Sprintf("key1=%s,key2=%s", k.Key1, k.Key2)
+}
+
+func lmkItemKeyFn(i *lmkItem) lmkItemKey {
+	return lmkItemKey{Key1: i.Key1, Key2: i.Key2}
+}
+
+func TestListMapElementByKeys(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	newList := []lmkItem{
+		{Key1: "a", Key2: "b", Data: "new"},
+		{Key1: "c", Key2: "d", Data: "unrelated"},
+	}
+	oldList := []lmkItem{
+		{Key1: "a", Key2: "b", Data: "old"},
+	}
+
+	var gotPath *field.Path
+	var gotNew, gotOld *lmkItem
+	errs := ListMapElementByKeys(ctx, op, path, newList, oldList, lmkItemKeyFn, lmkItemKey{Key1: "a", Key2: "b"},
+		func(_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, oldObj *lmkItem) field.ErrorList {
+			gotPath, gotNew, gotOld = fldPath, newObj, oldObj
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if want := `items[key1=a,key2=b]`; gotPath == nil || gotPath.String() != want {
+		t.Errorf("expected path %q, got %v", want, gotPath)
+	}
+	if gotNew == nil || gotNew.Data != "new" {
+		t.Errorf("expected matched new item with Data %q, got %v", "new", gotNew)
+	}
+	if gotOld == nil || gotOld.Data != "old" {
+		t.Errorf("expected matched old item with Data %q, got %v", "old", gotOld)
+	}
+}
+
+func TestListMapElementByKeysNoMatch(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	list := []lmkItem{{Key1: "x", Key2: "y", Data: "d"}}
+
+	called := false
+	errs := ListMapElementByKeys(ctx, op, path, list, list, lmkItemKeyFn, lmkItemKey{Key1: "a", Key2: "b"},
+		func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *lmkItem) field.ErrorList {
+			called = true
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if called {
+		t.Errorf("expected elementValidator not to be called when nothing matches")
+	}
+}
+
+func TestListMapElementByKeysOnlyInOldList(t *testing.T) {
+	path := field.NewPath("items")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	oldList := []lmkItem{{Key1: "a", Key2: "b", Data: "removed"}}
+	var newList []lmkItem
+
+	var gotNew, gotOld *lmkItem
+	errs := ListMapElementByKeys(ctx, op, path, newList, oldList, lmkItemKeyFn, lmkItemKey{Key1: "a", Key2: "b"},
+		func(_ context.Context, _ operation.Operation, _ *field.Path, newObj, oldObj *lmkItem) field.ErrorList {
+			gotNew, gotOld = newObj, oldObj
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if gotNew != nil {
+		t.Errorf("expected nil matched new item, got %v", gotNew)
+	}
+	if gotOld == nil || gotOld.Data != "removed" {
+		t.Errorf("expected matched old item with Data %q, got %v", "removed", gotOld)
+	}
+}
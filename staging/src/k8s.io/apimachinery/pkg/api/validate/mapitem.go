@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MapItem validates the value stored under a single, statically-known key of
+// a Go-native map, analogous to ListMapItemByKeyValues for list-map items. If
+// neither newMap nor oldMap has an entry under key, itemValidator is not
+// called.
+func MapItem[M ~map[K]V, K comparable, V any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newMap, oldMap M, key K,
+	itemValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *V) field.ErrorList,
+) field.ErrorList {
+	var newVal, oldVal *V
+	if v, found := newMap[key]; found {
+		newVal = &v
+	}
+	if v, found := oldMap[key]; found {
+		oldVal = &v
+	}
+	if newVal == nil && oldVal == nil {
+		return nil
+	}
+	return itemValidator(ctx, op, fldPath.Key(fmt.Sprintf("%v", key)), newVal, oldVal)
+}
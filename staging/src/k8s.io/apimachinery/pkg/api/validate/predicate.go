@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MatchesPredicate validates that pred(newObj) holds, reporting
+// field.Invalid at fldPath with the given origin otherwise. Unlike
+// MatchesCEL, pred is not a cel.Program: it's a closure the generator
+// lowered straight to Go source at generation time, so evaluating it here
+// has no CEL runtime involved at all.
+func MatchesPredicate[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, _ *T, origin string, pred func(*T) bool) field.ErrorList {
+	if pred(newObj) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, nil, "failed validation").WithOrigin(origin)}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestMatchesPredicate(t *testing.T) {
+	path := field.NewPath("test")
+	type obj struct {
+		Replicas, MaxReplicas int
+	}
+	pred := func(o *obj) bool { return o.Replicas <= o.MaxReplicas }
+
+	if errs := MatchesPredicate(context.Background(), operation.Operation{}, path, &obj{Replicas: 2, MaxReplicas: 5}, nil, "cel=abc123", pred); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	errs := MatchesPredicate(context.Background(), operation.Operation{}, path, &obj{Replicas: 6, MaxReplicas: 5}, nil, "cel=abc123", pred)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if got := errs[0].Origin; got != "cel=abc123" {
+		t.Errorf("expected origin %q, got %q", "cel=abc123", got)
+	}
+}
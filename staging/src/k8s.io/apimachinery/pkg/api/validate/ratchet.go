@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+// RatchetPolicy controls when a validator may be skipped on update because
+// the field did not meaningfully change, letting previously-stored invalid
+// data round-trip through updates that don't touch it (the behavior
+// +k8s:eqOneOf's tests exercise by passing OldValue(invalid) and expecting
+// ExpectValid).
+type RatchetPolicy string
+
+const (
+	// RatchetAlways skips the validator whenever an old value is present,
+	// regardless of whether it changed.
+	RatchetAlways RatchetPolicy = "Always"
+	// RatchetNever never skips the validator: it runs on every value, even
+	// one that is unchanged from the old value.
+	RatchetNever RatchetPolicy = "Never"
+	// RatchetIfOldEquivalent skips the validator only when the new value is
+	// semantically equivalent to the old value (by reflectEqual, the same
+	// comparison ImmutableByReflect and FrozenByReflect use: an Equaler's
+	// EqualTo, else equality.Semantic.DeepEqual), rather than requiring an
+	// exact match. Prefer
+	// this over a direct comparison when round-tripping through defaulting
+	// can change a value's representation without changing its meaning.
+	RatchetIfOldEquivalent RatchetPolicy = "IfOldEquivalent"
+)
+
+// ShouldRatchet reports whether, under policy, a validator should be
+// skipped given the old and new values. hasOld is false when there is no
+// prior value to compare against (e.g. a create operation), in which case
+// ratcheting never applies.
+func ShouldRatchet[T any](policy RatchetPolicy, value, oldValue T, hasOld bool) bool {
+	if !hasOld {
+		return false
+	}
+	switch policy {
+	case RatchetAlways:
+		return true
+	case RatchetIfOldEquivalent:
+		return reflectEqual(value, oldValue)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import "testing"
+
+func TestShouldRatchet(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		policy   RatchetPolicy
+		value    string
+		oldValue string
+		hasOld   bool
+		want     bool
+	}{{
+		name:   "always, no old value",
+		policy: RatchetAlways,
+		hasOld: false,
+		want:   false,
+	}, {
+		name:     "always, changed",
+		policy:   RatchetAlways,
+		value:    "a",
+		oldValue: "b",
+		hasOld:   true,
+		want:     true,
+	}, {
+		name:     "never, unchanged",
+		policy:   RatchetNever,
+		value:    "a",
+		oldValue: "a",
+		hasOld:   true,
+		want:     false,
+	}, {
+		name:     "if-old-equivalent, unchanged",
+		policy:   RatchetIfOldEquivalent,
+		value:    "a",
+		oldValue: "a",
+		hasOld:   true,
+		want:     true,
+	}, {
+		name:     "if-old-equivalent, changed",
+		policy:   RatchetIfOldEquivalent,
+		value:    "a",
+		oldValue: "b",
+		hasOld:   true,
+		want:     false,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ShouldRatchet(tc.policy, tc.value, tc.oldValue, tc.hasOld); got != tc.want {
+				t.Errorf("ShouldRatchet() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestShouldRatchetStructSubtree exercises ShouldRatchet against a nested
+// struct value rather than a scalar, the shape a generated Validate_T2 call
+// guarding a struct-typed field (e.g. T1.T2) would compare. ShouldRatchet's
+// IfOldEquivalent policy is already generic over T, so it skips an unchanged
+// struct subtree the same way it skips an unchanged scalar -- no
+// struct-specific helper is needed for the comparison itself.
+func TestShouldRatchetStructSubtree(t *testing.T) {
+	type T2 struct {
+		A string
+		B []int
+	}
+
+	unchanged := T2{A: "x", B: []int{1, 2}}
+	changed := T2{A: "x", B: []int{1, 3}}
+
+	if !ShouldRatchet(RatchetIfOldEquivalent, unchanged, unchanged, true) {
+		t.Errorf("expected an unchanged struct subtree to ratchet")
+	}
+	if ShouldRatchet(RatchetIfOldEquivalent, unchanged, changed, true) {
+		t.Errorf("expected a changed struct subtree not to ratchet")
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteJSONL encodes records to w as JSON Lines: one ValidationRecord object
+// per line, in order, so a large report can be streamed and consumed
+// incrementally rather than built up as one JSON array in memory.
+func WriteJSONL(w io.Writer, records []ValidationRecord) error {
+	enc := json.NewEncoder(w)
+	for i, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return fmt.Errorf("encoding record %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestWriteJSONL(t *testing.T) {
+	records := []ValidationRecord{
+		{Path: "spec.replicas", Origin: "k8s:minimum", RuleID: "minimum", Severity: "error", NewValue: -1},
+		{Path: "spec.template", Origin: "k8s:immutable", RuleID: "immutable", Severity: "ok", Skipped: true, SkipReason: "unchanged"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJSONL(&buf, records); err != nil {
+		t.Fatalf("WriteJSONL returned an error: %v", err)
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	var got []ValidationRecord
+	for scanner.Scan() {
+		var r ValidationRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("decoding line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, r)
+	}
+	if len(got) != len(records) {
+		t.Fatalf("expected %d lines, got %d", len(records), len(got))
+	}
+	for i := range records {
+		want := records[i]
+		if got[i].Path != want.Path || got[i].Origin != want.Origin || got[i].RuleID != want.RuleID ||
+			got[i].Severity != want.Severity || got[i].Skipped != want.Skipped || got[i].SkipReason != want.SkipReason {
+			t.Errorf("record %d: expected %+v, got %+v", i, want, got[i])
+		}
+	}
+}
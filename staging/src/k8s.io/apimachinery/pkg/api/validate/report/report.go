@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package report defines a machine-readable, streamable record of what a
+// generated Validate_T<N> did: which rules it ran, which it skipped (and
+// why), and what they found -- as a sibling to the field.ErrorList every
+// Validate_T<N> already returns, not a replacement for it.
+package report
+
+// ValidationRecord describes the outcome of a single validation rule
+// evaluated against one field: the rule that ran (Origin/RuleID), where it
+// ran (Path), what it saw (OldValue/NewValue), what it found (Severity --
+// e.g. "error" for an entry that produced a field.Error, "ok" otherwise),
+// and whether it ran at all (Skipped/SkipReason, e.g. "unchanged" when a
+// ratchet short-circuit fired and the underlying validator was never
+// invoked).
+type ValidationRecord struct {
+	Path       string `json:"path"`
+	Origin     string `json:"origin"`
+	RuleID     string `json:"ruleID"`
+	Severity   string `json:"severity"`
+	OldValue   any    `json:"oldValue,omitempty"`
+	NewValue   any    `json:"newValue,omitempty"`
+	Skipped    bool   `json:"skipped,omitempty"`
+	SkipReason string `json:"skipReason,omitempty"`
+}
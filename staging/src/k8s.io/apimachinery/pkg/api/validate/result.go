@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import "k8s.io/apimachinery/pkg/util/validation/field"
+
+// Result aggregates the outcome of scoped-enforcement validations, i.e.
+// validations whose tag declared an `action=` other than the default
+// "deny". It lets a single validation tag surface a mix of hard failures,
+// non-blocking warnings, and audit annotations, so that an apiserver can
+// deny the request only for action=deny violations while still surfacing
+// action=warn violations (e.g. via the response "Warning:" header) and
+// action=audit violations (e.g. as audit annotations).
+type Result struct {
+	// Errors are action=deny violations. A non-empty Errors means the
+	// request must be rejected.
+	Errors field.ErrorList
+	// Warnings are human-readable messages for action=warn violations.
+	// They do not block the request.
+	Warnings []string
+	// AuditAnnotations are action=audit violations, keyed by the
+	// validation tag's origin (e.g. "k8s:eqOneOf"). They do not block the
+	// request.
+	AuditAnnotations map[string]string
+}
+
+// Empty reports whether the result carries no errors, warnings, or audit
+// annotations.
+func (r Result) Empty() bool {
+	return len(r.Errors) == 0 && len(r.Warnings) == 0 && len(r.AuditAnnotations) == 0
+}
+
+// StrictErrors returns r.Errors with r.Warnings appended as additional
+// field.Invalid entries. It lets a caller (typically a test, or a scheme
+// opting into stricter validation) treat action=warn violations as hard
+// failures without having to special-case Result throughout its own code.
+// AuditAnnotations are not represented in a field.ErrorList and are dropped.
+func (r Result) StrictErrors() field.ErrorList {
+	if len(r.Warnings) == 0 {
+		return r.Errors
+	}
+	errs := make(field.ErrorList, 0, len(r.Errors)+len(r.Warnings))
+	errs = append(errs, r.Errors...)
+	for _, w := range r.Warnings {
+		errs = append(errs, field.Invalid(field.NewPath(""), nil, w))
+	}
+	return errs
+}
+
+// Merge folds other into r in place.
+func (r *Result) Merge(other Result) {
+	r.Errors = append(r.Errors, other.Errors...)
+	r.Warnings = append(r.Warnings, other.Warnings...)
+	if len(other.AuditAnnotations) == 0 {
+		return
+	}
+	if r.AuditAnnotations == nil {
+		r.AuditAnnotations = make(map[string]string, len(other.AuditAnnotations))
+	}
+	for k, v := range other.AuditAnnotations {
+		r.AuditAnnotations[k] = v
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// RunFieldValidators runs fns -- one independent field validator closure per
+// call, in the order a generated Validate_T<N> would register them -- and
+// concatenates their results in that same order, regardless of whether they
+// ran sequentially or concurrently. A parallelism of 1 or less runs fns
+// sequentially, in registration order, with no goroutines at all; this is
+// the default and is behaviorally identical to an inline append chain.
+//
+// A parallelism greater than 1 fans fns out over a worker pool of that size.
+// Results are still concatenated in registration order -- only the
+// execution, not the output, is reordered. If ctx is canceled, no further
+// fns are started, but fns already running are allowed to finish and their
+// results are still included; RunFieldValidators itself never returns early,
+// since doing so would make a large struct's error output depend on timing.
+// A validator that panics contributes a single field.InternalError at
+// fldPath in place of its own result, instead of crashing the run.
+func RunFieldValidators(ctx context.Context, fldPath *field.Path, parallelism int, fns []func() field.ErrorList) field.ErrorList {
+	results := make([]field.ErrorList, len(fns))
+
+	run := func(i int) {
+		defer func() {
+			if r := recover(); r != nil {
+				results[i] = field.ErrorList{field.InternalError(fldPath, fmt.Errorf("validator panicked: %v", r))}
+			}
+		}()
+		results[i] = fns[i]()
+	}
+
+	if parallelism <= 1 {
+		for i := range fns {
+			run(i)
+		}
+	} else {
+		sem := make(chan struct{}, parallelism)
+		var wg sync.WaitGroup
+		for i := range fns {
+			if ctx.Err() != nil {
+				break
+			}
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				run(i)
+			}(i)
+		}
+		wg.Wait()
+	}
+
+	var errs field.ErrorList
+	for _, r := range results {
+		errs = append(errs, r...)
+	}
+	return errs
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestRunFieldValidatorsOrdering(t *testing.T) {
+	path := field.NewPath("test")
+	fns := make([]func() field.ErrorList, 10)
+	for i := 0; i < 10; i++ {
+		i := i
+		fns[i] = func() field.ErrorList {
+			return field.ErrorList{field.Invalid(path.Child(fmt.Sprintf("f%d", i)), nil, "bad")}
+		}
+	}
+
+	for _, parallelism := range []int{1, 4} {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			errs := RunFieldValidators(context.Background(), path, parallelism, fns)
+			if len(errs) != 10 {
+				t.Fatalf("expected 10 errors, got %d: %s", len(errs), fmtErrs(errs))
+			}
+			for i, err := range errs {
+				want := path.Child(fmt.Sprintf("f%d", i)).String()
+				if err.Field != want {
+					t.Errorf("error %d: expected field %q, got %q (order not preserved)", i, want, err.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestRunFieldValidatorsPanicRecovery(t *testing.T) {
+	path := field.NewPath("test")
+	fns := []func() field.ErrorList{
+		func() field.ErrorList { panic("boom") },
+		func() field.ErrorList { return nil },
+	}
+
+	for _, parallelism := range []int{1, 2} {
+		errs := RunFieldValidators(context.Background(), path, parallelism, fns)
+		if len(errs) != 1 {
+			t.Fatalf("parallelism=%d: expected 1 error, got %d: %s", parallelism, len(errs), fmtErrs(errs))
+		}
+		if errs[0].Type != field.ErrorTypeInternal {
+			t.Errorf("parallelism=%d: expected an internal error, got %s", parallelism, errs[0].Type)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"math"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// SemanticEqualityOptions configures which normalizations FrozenBySemantic
+// and ImmutableBySemantic apply before deciding whether two values are "the
+// same", so API authors can pick the laxness that matches how their type
+// actually round-trips through JSON/protobuf, rather than the fixed
+// behavior FrozenByReflect/ImmutableByReflect get from equality.Semantic.
+type SemanticEqualityOptions struct {
+	// TreatNilAsEmpty treats a nil slice/map as equal to a non-nil
+	// slice/map of the same type with zero elements.
+	TreatNilAsEmpty bool
+	// IgnoreMapOrder documents that map comparison does not depend on the
+	// order entries were inserted in. Map comparison is inherently
+	// order-independent regardless of this option's value; it exists so
+	// callers can state the assumption explicitly rather than leave it
+	// implicit.
+	IgnoreMapOrder bool
+	// NumericCrossType allows two numeric values to compare equal across
+	// differing int/uint/float kinds and widths, by comparing their
+	// float64 value (e.g. int32(3) == float64(3)), for APIs whose
+	// JSON/protobuf encodings can alias a numeric field's concrete type
+	// across round-trips.
+	NumericCrossType bool
+}
+
+// DefaultSemanticEqualityOptions enables every normalization, matching the
+// laxest reasonable interpretation of "unchanged" for a value that has
+// round-tripped through JSON: nil and empty are indistinguishable, map
+// order never matters, and numeric width/kind is not semantically
+// meaningful.
+func DefaultSemanticEqualityOptions() SemanticEqualityOptions {
+	return SemanticEqualityOptions{
+		TreatNilAsEmpty:  true,
+		IgnoreMapOrder:   true,
+		NumericCrossType: true,
+	}
+}
+
+// FrozenBySemantic verifies that the specified value has not changed in the
+// course of an update operation, comparing under opts rather than
+// reflect.DeepEqual/equality.Semantic. It does nothing if the old value is
+// not provided.
+// Semantics:
+// - Forbids ALL transitions after creation
+// - This includes: set->unset (set), unset->set (clear), and modify
+func FrozenBySemantic[T any](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T, opts SemanticEqualityOptions) field.ErrorList {
+	if op.Type != operation.Update {
+		return nil
+	}
+	if !semanticDeepEqual(reflect.ValueOf(value), reflect.ValueOf(oldValue), opts) {
+		return field.ErrorList{
+			field.Forbidden(fldPath, "field is frozen"+diffDetail(oldValue, value)),
+		}
+	}
+	return nil
+}
+
+// ImmutableBySemantic allows a field to be set once then prevents any
+// further changes, comparing under opts rather than
+// reflect.DeepEqual/equality.Semantic.
+// Semantics:
+// - Can be unset at creation
+// - Allows ONE transition: set (unset->set)
+// - Forbids: modify and clear (set->unset)
+func ImmutableBySemantic[T any](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T, opts SemanticEqualityOptions) field.ErrorList {
+	if op.Type != operation.Update {
+		return nil
+	}
+	if semanticDeepEqual(reflect.ValueOf(value), reflect.ValueOf(oldValue), opts) {
+		return nil
+	}
+	oldValueIsUnset := isUnsetForReflect(oldValue)
+	valueIsUnset := isUnsetForReflect(value)
+	if oldValueIsUnset && !valueIsUnset {
+		return nil
+	}
+	return field.ErrorList{
+		field.Forbidden(fldPath, "field is immutable"+diffDetail(oldValue, value)),
+	}
+}
+
+// semanticDeepEqual recursively compares a and b under opts. Pointers and
+// interfaces are dereferenced transparently (a nil pointer/interface on one
+// side and not the other is never equal, the same "unset" rule
+// isUnsetForReflect applies elsewhere); slices, maps, and structs recurse
+// element-by-element so a nested numeric or nil/empty difference is
+// normalized the same way the top-level value would be.
+func semanticDeepEqual(a, b reflect.Value, opts SemanticEqualityOptions) bool {
+	a = derefSemantic(a)
+	b = derefSemantic(b)
+
+	if !a.IsValid() || !b.IsValid() {
+		return !a.IsValid() && !b.IsValid()
+	}
+
+	if opts.NumericCrossType && isNumericKind(a.Kind()) && isNumericKind(b.Kind()) {
+		return numericValue(a) == numericValue(b)
+	}
+
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && !opts.TreatNilAsEmpty && a.IsNil() != b.IsNil() {
+			return false
+		}
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !semanticDeepEqual(a.Index(i), b.Index(i), opts) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if !opts.TreatNilAsEmpty && a.IsNil() != b.IsNil() {
+			return false
+		}
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, k := range a.MapKeys() {
+			bv := b.MapIndex(k)
+			if !bv.IsValid() || !semanticDeepEqual(a.MapIndex(k), bv, opts) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < a.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue // unexported
+			}
+			if !semanticDeepEqual(a.Field(i), b.Field(i), opts) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// derefSemantic unwraps pointers and interfaces, returning the zero Value
+// if it bottoms out at nil.
+func derefSemantic(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func numericValue(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	case v.CanFloat():
+		return v.Float()
+	}
+	return math.NaN()
+}
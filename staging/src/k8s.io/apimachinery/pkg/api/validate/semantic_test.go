@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type semanticSpec struct {
+	Names   []string
+	Tags    map[string]string
+	Nested  *StructComparable
+	Replica int32
+}
+
+func TestFrozenBySemantic(t *testing.T) {
+	path := field.NewPath("test")
+
+	for _, tc := range []struct {
+		name     string
+		opts     SemanticEqualityOptions
+		oldValue semanticSpec
+		value    semanticSpec
+		wantErr  bool
+	}{
+		{
+			name:     "nil vs empty slice, TreatNilAsEmpty",
+			opts:     SemanticEqualityOptions{TreatNilAsEmpty: true},
+			oldValue: semanticSpec{Names: nil},
+			value:    semanticSpec{Names: []string{}},
+		},
+		{
+			name:     "nil vs empty slice, not normalized",
+			opts:     SemanticEqualityOptions{},
+			oldValue: semanticSpec{Names: nil},
+			value:    semanticSpec{Names: []string{}},
+			wantErr:  true,
+		},
+		{
+			name:     "nil vs empty map, TreatNilAsEmpty",
+			opts:     SemanticEqualityOptions{TreatNilAsEmpty: true},
+			oldValue: semanticSpec{Tags: nil},
+			value:    semanticSpec{Tags: map[string]string{}},
+		},
+		{
+			name:     "nil vs empty map, not normalized",
+			opts:     SemanticEqualityOptions{},
+			oldValue: semanticSpec{Tags: nil},
+			value:    semanticSpec{Tags: map[string]string{}},
+			wantErr:  true,
+		},
+		{
+			name:     "map with same entries in different insertion order",
+			opts:     SemanticEqualityOptions{IgnoreMapOrder: true},
+			oldValue: semanticSpec{Tags: map[string]string{"a": "1", "b": "2"}},
+			value:    semanticSpec{Tags: map[string]string{"b": "2", "a": "1"}},
+		},
+		{
+			name:     "nested struct reached through a pointer, unchanged",
+			oldValue: semanticSpec{Nested: &StructComparable{S: "x"}},
+			value:    semanticSpec{Nested: &StructComparable{S: "x"}},
+		},
+		{
+			name:     "nested struct reached through a pointer, changed",
+			oldValue: semanticSpec{Nested: &StructComparable{S: "x"}},
+			value:    semanticSpec{Nested: &StructComparable{S: "y"}},
+			wantErr:  true,
+		},
+		{
+			name:     "one nested pointer nil, the other set",
+			oldValue: semanticSpec{Nested: nil},
+			value:    semanticSpec{Nested: &StructComparable{}},
+			wantErr:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := FrozenBySemantic(context.Background(), operation.Operation{Type: operation.Update}, path, tc.value, tc.oldValue, tc.opts)
+			if tc.wantErr && len(errs) == 0 {
+				t.Errorf("expected an error, got none")
+			}
+			if !tc.wantErr && len(errs) != 0 {
+				t.Errorf("expected no errors, got %s", fmtErrs(errs))
+			}
+		})
+	}
+}
+
+func TestFrozenBySemanticNumericCrossType(t *testing.T) {
+	path := field.NewPath("test")
+	op := operation.Operation{Type: operation.Update}
+
+	if errs := FrozenBySemantic(context.Background(), op, path, int32(3), int64(3), SemanticEqualityOptions{NumericCrossType: true}); len(errs) != 0 {
+		t.Errorf("expected int32(3) == int64(3) under NumericCrossType, got %s", fmtErrs(errs))
+	}
+	if errs := FrozenBySemantic(context.Background(), op, path, int32(3), int64(3), SemanticEqualityOptions{}); len(errs) == 0 {
+		t.Errorf("expected int32(3) != int64(3) without NumericCrossType, got none")
+	}
+	if errs := FrozenBySemantic(context.Background(), op, path, float64(3), int64(4), SemanticEqualityOptions{NumericCrossType: true}); len(errs) == 0 {
+		t.Errorf("expected 3 != 4 under NumericCrossType, got none")
+	}
+}
+
+func TestImmutableBySemantic(t *testing.T) {
+	path := field.NewPath("test")
+	op := operation.Operation{Type: operation.Update}
+	opts := DefaultSemanticEqualityOptions()
+
+	unset := semanticSpec{}
+	set := semanticSpec{Names: []string{"a"}}
+	setDifferently := semanticSpec{Names: []string{"b"}}
+
+	if errs := ImmutableBySemantic(context.Background(), op, path, set, unset, opts); len(errs) != 0 {
+		t.Errorf("expected no errors for unset->set, got %s", fmtErrs(errs))
+	}
+	if errs := ImmutableBySemantic(context.Background(), op, path, setDifferently, set, opts); len(errs) == 0 {
+		t.Errorf("expected an error for set->set, got none")
+	}
+	if errs := ImmutableBySemantic(context.Background(), op, path, unset, set, opts); len(errs) == 0 {
+		t.Errorf("expected an error for set->unset, got none")
+	}
+}
+
+func TestFrozenSemanticVariantsConsistency(t *testing.T) {
+	// Extends TestFrozenVariantsConsistency with a normalization axis:
+	// under DefaultSemanticEqualityOptions, FrozenBySemantic should agree
+	// with FrozenByReflect everywhere FrozenByReflect already considers
+	// nil/empty equal or maps differently ordered, and treat all of these
+	// cases as unchanged.
+	path := field.NewPath("test")
+	op := operation.Operation{Type: operation.Update}
+	opts := DefaultSemanticEqualityOptions()
+
+	for _, tc := range []struct {
+		name     string
+		oldValue semanticSpec
+		value    semanticSpec
+	}{
+		{"both zero", semanticSpec{}, semanticSpec{}},
+		{"nil to empty slice", semanticSpec{Names: nil}, semanticSpec{Names: []string{}}},
+		{"nil to empty map", semanticSpec{Tags: nil}, semanticSpec{Tags: map[string]string{}}},
+		{"reordered map", semanticSpec{Tags: map[string]string{"a": "1", "b": "2"}}, semanticSpec{Tags: map[string]string{"b": "2", "a": "1"}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errsReflect := FrozenByReflect(context.Background(), op, path, tc.value, tc.oldValue)
+			errsSemantic := FrozenBySemantic(context.Background(), op, path, tc.value, tc.oldValue, opts)
+			if len(errsReflect) != len(errsSemantic) {
+				t.Errorf("FrozenByReflect and FrozenBySemantic differ for %q: %s, %s",
+					tc.name, fmtErrs(errsReflect), fmtErrs(errsSemantic))
+			}
+			if len(errsSemantic) != 0 {
+				t.Errorf("expected FrozenBySemantic to treat %q as unchanged, got %s", tc.name, fmtErrs(errsSemantic))
+			}
+		})
+	}
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Matches validates that the specified value matches re. humanReadable
+// describes the expected shape (e.g. "must be a lowercase letter followed by
+// ...") and is used verbatim in the error message; re is expected to be a
+// package-level *regexp.Regexp shared by every call site for the same
+// pattern, so it is compiled exactly once regardless of how many times this
+// field is validated.
+func Matches(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string, re *regexp.Regexp, humanReadable string) field.ErrorList {
+	if err := matchesCheck(fldPath, value, re, humanReadable); err != nil {
+		return field.ErrorList{err}
+	}
+	return nil
+}
+
+// MatchesWarn is like Matches, but for tags declared with `action=warn`: a
+// violation is reported as a warning rather than a denial.
+func MatchesWarn(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string, re *regexp.Regexp, humanReadable string) Result {
+	if err := matchesCheck(fldPath, value, re, humanReadable); err != nil {
+		return Result{Warnings: []string{err.Error()}}
+	}
+	return Result{}
+}
+
+// MatchesAudit is like Matches, but for tags declared with `action=audit`: a
+// violation is recorded as an audit annotation rather than a denial.
+func MatchesAudit(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string, re *regexp.Regexp, humanReadable string) Result {
+	if err := matchesCheck(fldPath, value, re, humanReadable); err != nil {
+		return Result{AuditAnnotations: map[string]string{"k8s:pattern/" + fldPath.String(): err.Error()}}
+	}
+	return Result{}
+}
+
+// matchesCheck holds the comparison shared by Matches and its scoped
+// (warn/audit) variants.
+func matchesCheck(fldPath *field.Path, value *string, re *regexp.Regexp, humanReadable string) *field.Error {
+	if value == nil {
+		return nil
+	}
+	if !re.MatchString(*value) {
+		return field.Invalid(fldPath, *value, humanReadable).WithOrigin("k8s:pattern")
+	}
+	return nil
+}
+
+// dns1123SubdomainRE matches a DNS subdomain as defined by RFC 1123: one or
+// more lowercase RFC 1123 labels separated by dots.
+var dns1123SubdomainRE = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// DNS1123Subdomain validates that the specified value is a DNS subdomain as
+// defined by RFC 1123.
+func DNS1123Subdomain(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if len(*value) > 253 || !dns1123SubdomainRE.MatchString(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a DNS subdomain, as defined in RFC 1123").WithOrigin("k8s:format:dns1123Subdomain")}
+	}
+	return nil
+}
+
+// dns1035LabelRE matches a DNS label as defined by RFC 1035: a single
+// alphanumeric-and-dash label that starts with a lowercase letter.
+var dns1035LabelRE = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+// DNS1035Label validates that the specified value is a DNS label as defined
+// by RFC 1035.
+func DNS1035Label(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if len(*value) > 63 || !dns1035LabelRE.MatchString(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a DNS label, as defined in RFC 1035").WithOrigin("k8s:format:dns1035Label")}
+	}
+	return nil
+}
+
+// shortNameRE matches a Kubernetes "short name": a single RFC 1123 label
+// (lowercase alphanumerics and dashes, not starting or ending with a dash).
+// Unlike DNS1035Label, a leading digit is allowed.
+var shortNameRE = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// ShortName validates that the specified value is a Kubernetes "short name",
+// the format typically used for object names: an RFC 1123 label of at most
+// 63 characters.
+func ShortName(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if len(*value) == 0 || len(*value) > 63 || !shortNameRE.MatchString(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid short name (an RFC 1123 label, at most 63 characters)").WithOrigin("format=k8s-short-name")}
+	}
+	return nil
+}
+
+// LongName validates that the specified value is a Kubernetes "long name":
+// a DNS subdomain (one or more RFC 1123 labels joined by dots) of at most
+// 253 characters.
+func LongName(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if len(*value) == 0 || len(*value) > 253 || !dns1123SubdomainRE.MatchString(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid long name (a DNS subdomain, at most 253 characters)").WithOrigin("format=k8s-long-name")}
+	}
+	return nil
+}
+
+// maskTrailingDash replaces a trailing dash (and the character before it)
+// with "x", so a generateName base can be validated as a well-formed name
+// before the random suffix that metadata.generateName appends is known.
+func maskTrailingDash(name string) string {
+	if len(name) >= 2 && strings.HasSuffix(name, "-") {
+		return name[:len(name)-2] + "x"
+	}
+	return name
+}
+
+// GenerateName runs validator against value with any trailing dash masked
+// out first (see maskTrailingDash), since metadata.generateName permits a
+// trailing dash that the wrapped format would otherwise reject.
+func GenerateName(ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *string, validator func(context.Context, operation.Operation, *field.Path, *string, *string) field.ErrorList) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	masked := maskTrailingDash(*value)
+	return validator(ctx, op, fldPath, &masked, oldValue)
+}
+
+// IPv4 validates that the specified value is a dotted-quad IPv4 address.
+func IPv4(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if ip := net.ParseIP(*value); ip == nil || ip.To4() == nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid IPv4 address").WithOrigin("k8s:format:ipv4")}
+	}
+	return nil
+}
+
+// IPv6 validates that the specified value is an IPv6 address.
+func IPv6(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if ip := net.ParseIP(*value); ip == nil || ip.To4() != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid IPv6 address").WithOrigin("k8s:format:ipv6")}
+	}
+	return nil
+}
+
+// CIDR validates that the specified value is an IPv4 or IPv6 CIDR block.
+func CIDR(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid CIDR block").WithOrigin("k8s:format:cidr")}
+	}
+	return nil
+}
+
+// AbsolutePath validates that the specified value is an absolute (slash
+// rooted and cleaned) file path.
+func AbsolutePath(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if !path.IsAbs(*value) || path.Clean(*value) != *value {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be an absolute path").WithOrigin("k8s:format:absolutePath")}
+	}
+	return nil
+}
+
+// uuidRE matches the canonical 8-4-4-4-12 hyphenated hex form of a UUID.
+var uuidRE = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// UUID validates that the specified value is a canonically-formatted UUID.
+func UUID(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if !uuidRE.MatchString(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid UUID").WithOrigin("k8s:format:uuid")}
+	}
+	return nil
+}
+
+// Base64 validates that the specified value is standard (RFC 4648) base64
+// encoded data.
+func Base64(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := base64.StdEncoding.DecodeString(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be base64 encoded: %v", err)).WithOrigin("k8s:format:base64")}
+	}
+	return nil
+}
+
+// URL validates that the specified value is an absolute URL.
+func URL(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	u, err := url.ParseRequestURI(*value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be an absolute URL").WithOrigin("k8s:format:url")}
+	}
+	return nil
+}
+
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+// parseSingleEmailAddress validates that value is exactly one RFC 5322
+// address, as net/mail's ParseAddress parses it (ParseAddress itself rejects
+// trailing text, address lists and empty groups), and additionally rejects
+// an address carrying a display name.
+func parseSingleEmailAddress(value string) error {
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		return err
+	}
+	if addr.Name != "" {
+		// ParseAddress tolerates a display name, e.g. `"A B" <a@b.com>`;
+		// the "email" format is just the bare address.
+		return fmt.Errorf("must not include a display name")
+	}
+	return nil
+}
+
+// Email validates that the specified value is an RFC 5322 email address
+// restricted to ASCII, as JSON Schema 2020-12's "email" format requires. For
+// an internationalized address (a Unicode local-part or domain), use
+// IDNEmail instead.
+func Email(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if !isASCII(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be an ASCII email address; use idn-email for an internationalized address").WithOrigin("k8s:format:email")}
+	}
+	if err := parseSingleEmailAddress(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be a valid email address: %v", err)).WithOrigin("k8s:format:email")}
+	}
+	return nil
+}
+
+// IDNEmail validates that the specified value is an RFC 6531
+// internationalized email address: the same as Email, but permitting a
+// Unicode local-part and/or domain.
+func IDNEmail(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if err := parseSingleEmailAddress(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be a valid email address: %v", err)).WithOrigin("k8s:format:idn-email")}
+	}
+	return nil
+}
+
+// URI validates that the specified value is an absolute URI, as defined by
+// RFC 3986. Unlike URL, this accepts any scheme (not just http(s)-shaped
+// ones) so long as it has both a scheme and an opaque or hierarchical part.
+func URI(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	u, err := url.Parse(*value)
+	if err != nil || !u.IsAbs() {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be an absolute URI, as defined in RFC 3986").WithOrigin("k8s:format:uri")}
+	}
+	return nil
+}
+
+// URIReference validates that the specified value is a URI reference, as
+// defined by RFC 3986: either an absolute URI (see URI) or a relative
+// reference such as "/path" or "../other".
+func URIReference(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := url.Parse(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a URI reference, as defined in RFC 3986").WithOrigin("k8s:format:uri-reference")}
+	}
+	return nil
+}
+
+// Date validates that the specified value is a full-date as defined by RFC
+// 3339: "2006-01-02".
+func Date(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", *value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid date, as defined in RFC 3339 (YYYY-MM-DD)").WithOrigin("k8s:format:date")}
+	}
+	return nil
+}
+
+// Time validates that the specified value is a full-time as defined by RFC
+// 3339: "15:04:05Z" or "15:04:05+07:00".
+func Time(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := time.Parse("15:04:05Z07:00", *value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid time, as defined in RFC 3339 (hh:mm:ssZ or hh:mm:ss+hh:mm)").WithOrigin("k8s:format:time")}
+	}
+	return nil
+}
+
+// DateTime validates that the specified value is a date-time as defined by
+// RFC 3339.
+func DateTime(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, *value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid date-time, as defined in RFC 3339").WithOrigin("k8s:format:date-time")}
+	}
+	return nil
+}
+
+// durationRE matches an ISO 8601 duration: "P", then any of years/months/
+// weeks/days, optionally followed by "T" and any of hours/minutes/seconds.
+// Every part is optional, so it also matches a bare "P" or "PT"; Duration
+// rejects those by also requiring a digit.
+var durationRE = regexp.MustCompile(`^P(?:\d+Y)?(?:\d+M)?(?:\d+W)?(?:\d+D)?(T(?:\d+H)?(?:\d+M)?(?:\d+(?:\.\d+)?S)?)?$`)
+
+// Duration validates that the specified value is an ISO 8601 duration, e.g.
+// "P3Y6M4DT12H30M5S".
+func Duration(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if !durationRE.MatchString(*value) || !strings.ContainsAny(*value, "0123456789") {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid ISO 8601 duration, e.g. \"P3Y6M4DT12H30M5S\"").WithOrigin("k8s:format:duration")}
+	}
+	return nil
+}
+
+// hostnameRE matches a hostname as defined by RFC 1123: one or more labels
+// of letters, digits, and hyphens (not starting or ending with a hyphen),
+// separated by dots. Unlike DNS1123Subdomain, uppercase letters are allowed,
+// since hostnames are case-insensitive rather than k8s-name lowercase-only.
+var hostnameRE = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9]*[A-Za-z0-9])?(\.[A-Za-z0-9]([-A-Za-z0-9]*[A-Za-z0-9])?)*$`)
+
+// Hostname validates that the specified value is a hostname as defined by
+// RFC 1123.
+func Hostname(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if len(*value) > 253 || !hostnameRE.MatchString(*value) {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid hostname, as defined in RFC 1123").WithOrigin("k8s:format:hostname")}
+	}
+	return nil
+}
+
+// Regex validates that the specified value is itself a syntactically valid
+// (RE2) regular expression, i.e. it compiles with regexp.Compile.
+func Regex(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := regexp.Compile(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, fmt.Sprintf("must be a valid regular expression: %v", err)).WithOrigin("k8s:format:regex")}
+	}
+	return nil
+}
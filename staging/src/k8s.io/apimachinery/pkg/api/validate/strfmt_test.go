@@ -18,6 +18,7 @@ package validate
 
 import (
 	"context"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -308,3 +309,699 @@ func TestGenerateName(t *testing.T) {
 		})
 	}
 }
+
+func TestMatches(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid: matches",
+		input:    "abc",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: does not match",
+		input: "ABC",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:pattern"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Matches(ctx, operation.Operation{}, fldPath, &value, nil, re, "must be all lowercase letters")
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestMatchesWarn(t *testing.T) {
+	fldPath := field.NewPath("test")
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	val := "abc"
+	if result := MatchesWarn(context.Background(), operation.Operation{}, fldPath, &val, nil, re, "must be all lowercase letters"); !result.Empty() {
+		t.Errorf("expected empty result, got %+v", result)
+	}
+
+	bad := "ABC"
+	result := MatchesWarn(context.Background(), operation.Operation{}, fldPath, &bad, nil, re, "must be all lowercase letters")
+	if len(result.Errors) != 0 {
+		t.Errorf("expected no errors (action=warn must not deny), got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 {
+		t.Errorf("expected 1 warning, got %d: %v", len(result.Warnings), result.Warnings)
+	}
+}
+
+func TestMatchesAudit(t *testing.T) {
+	fldPath := field.NewPath("test")
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	bad := "ABC"
+	result := MatchesAudit(context.Background(), operation.Operation{}, fldPath, &bad, nil, re, "must be all lowercase letters")
+	if len(result.Errors) != 0 || len(result.Warnings) != 0 {
+		t.Errorf("expected no errors or warnings (action=audit must not deny or warn), got %+v", result)
+	}
+	if len(result.AuditAnnotations) != 1 {
+		t.Errorf("expected 1 audit annotation, got %d: %v", len(result.AuditAnnotations), result.AuditAnnotations)
+	}
+}
+
+func TestDNS1123Subdomain(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid: single label",
+		input:    "example",
+		wantErrs: nil,
+	}, {
+		name:     "valid: multiple labels",
+		input:    "www.example.com",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: uppercase",
+		input: "Example.com",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:dns1123Subdomain"),
+		},
+	}, {
+		name:  "invalid: empty string",
+		input: "",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:dns1123Subdomain"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := DNS1123Subdomain(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestDNS1035Label(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "abc-123",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: starts with digit",
+		input: "1abc",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:dns1035Label"),
+		},
+	}, {
+		name:  "invalid: contains dot",
+		input: "a.b",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:dns1035Label"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := DNS1035Label(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestIPv4(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "192.168.0.1",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: ipv6",
+		input: "::1",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:ipv4"),
+		},
+	}, {
+		name:  "invalid: not an ip",
+		input: "not-an-ip",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:ipv4"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := IPv4(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "::1",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: ipv4",
+		input: "192.168.0.1",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:ipv6"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := IPv6(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestCIDR(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "192.168.0.0/24",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: missing mask",
+		input: "192.168.0.0",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:cidr"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := CIDR(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestAbsolutePath(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "/etc/config",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: relative",
+		input: "etc/config",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:absolutePath"),
+		},
+	}, {
+		name:  "invalid: not cleaned",
+		input: "/etc/../config",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:absolutePath"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := AbsolutePath(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestUUID(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "123e4567-e89b-12d3-a456-426614174000",
+		wantErrs: nil,
+	}, {
+		name:  "invalid",
+		input: "not-a-uuid",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:uuid"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := UUID(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestBase64(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "aGVsbG8=",
+		wantErrs: nil,
+	}, {
+		name:  "invalid",
+		input: "not base64!!",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:base64"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Base64(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestURL(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "https://example.com/path",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: no scheme",
+		input: "example.com/path",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:url"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := URL(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestEmail(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "jane@example.com",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: no domain",
+		input: "jane@",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:email"),
+		},
+	}, {
+		name:  "invalid: display name",
+		input: `"Jane Doe" <jane@example.com>`,
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:email"),
+		},
+	}, {
+		name:  "invalid: non-ASCII",
+		input: "jané@example.com",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:email"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Email(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestIDNEmail(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid: ASCII",
+		input:    "jane@example.com",
+		wantErrs: nil,
+	}, {
+		name:     "valid: non-ASCII",
+		input:    "jané@example.com",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: no domain",
+		input: "jane@",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:idn-email"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := IDNEmail(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "urn:example:a123",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: relative reference",
+		input: "/path/only",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:uri"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := URI(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestURIReference(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid: absolute",
+		input:    "https://example.com/path",
+		wantErrs: nil,
+	}, {
+		name:     "valid: relative",
+		input:    "../other",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: unparsable",
+		input: "http://[::1",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:uri-reference"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := URIReference(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestDate(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "2025-01-31",
+		wantErrs: nil,
+	}, {
+		name:  "invalid",
+		input: "2025-31-01",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:date"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Date(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestTime(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid: UTC",
+		input:    "20:20:39Z",
+		wantErrs: nil,
+	}, {
+		name:     "valid: offset",
+		input:    "20:20:39+01:00",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: no offset",
+		input: "20:20:39",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:time"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Time(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "2025-01-31T20:20:39Z",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: missing time",
+		input: "2025-01-31",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:date-time"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := DateTime(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid: full",
+		input:    "P3Y6M4DT12H30M5S",
+		wantErrs: nil,
+	}, {
+		name:     "valid: weeks",
+		input:    "P2W",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: no designators",
+		input: "P",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:duration"),
+		},
+	}, {
+		name:  "invalid: missing P prefix",
+		input: "3Y6M4D",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:duration"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Duration(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestHostname(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    "Example-Host.example.com",
+		wantErrs: nil,
+	}, {
+		name:  "invalid: underscore",
+		input: "bad_host",
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:hostname"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Hostname(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestRegex(t *testing.T) {
+	ctx := context.Background()
+	fldPath := field.NewPath("test")
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErrs field.ErrorList
+	}{{
+		name:     "valid",
+		input:    `^[a-z]+\d*$`,
+		wantErrs: nil,
+	}, {
+		name:  "invalid: unbalanced group",
+		input: `(abc`,
+		wantErrs: field.ErrorList{
+			field.Invalid(fldPath, nil, "").WithOrigin("k8s:format:regex"),
+		},
+	}}
+
+	matcher := field.ErrorMatcher{}.ByType().ByField().ByOrigin()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value := tc.input
+			gotErrs := Regex(ctx, operation.Operation{}, fldPath, &value, nil)
+			matcher.Test(t, tc.wantErrs, gotErrs)
+		})
+	}
+}
@@ -99,13 +99,69 @@ func ListMapElementByKey[TList ~[]TItem, TItem any](
 
 	// Only proceed if at least one of them was found (and thus its pointer is non-nil)
 	if foundNewElementPtr != nil || foundOldElementPtr != nil {
-		elementPath := fldPath.Key(keyValue)
+		elementPath := fldPath.Key(FormatListMapKey(keyName, keyValue))
 		// If one is nil (not found), elementValidator gets a nil pointer, which is standard.
 		errs = append(errs, elementValidator(ctx, op, elementPath, foundNewElementPtr, foundOldElementPtr)...)
 	}
 	return errs
 }
 
+// ListMapElementByKeyValues generalizes ListMapElementByKey to a composite
+// (multi-field) key: keyNames and keyValues are parallel slices naming the
+// JSON fields that together identify a list-map element (e.g. a port
+// identified by both "port" and "protocol"), and an element matches when
+// every one of its named fields equals the corresponding value, via the same
+// reflection-based getReflectedJSONFieldValueAsString lookup
+// ListMapElementByKey uses for its single key. The path reported to
+// elementValidator joins every key=value pair with a comma, e.g.
+// `ports[port=80,protocol=TCP]`.
+func ListMapElementByKeyValues[TList ~[]TItem, TItem any](
+	ctx context.Context, op operation.Operation, fldPath *field.Path,
+	newList, oldList TList,
+	keyNames []string,
+	keyValues []string,
+	elementValidator func(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *TItem) field.ErrorList,
+) field.ErrorList {
+	var errs field.ErrorList
+
+	foundNewElementPtr := findListMapElementByKeyValues(newList, keyNames, keyValues)
+	foundOldElementPtr := findListMapElementByKeyValues(oldList, keyNames, keyValues)
+
+	if foundNewElementPtr != nil || foundOldElementPtr != nil {
+		parts := make([]string, len(keyNames))
+		for i := range keyNames {
+			parts[i] = FormatListMapKey(keyNames[i], keyValues[i])
+		}
+		elementPath := fldPath.Key(strings.Join(parts, ","))
+		errs = append(errs, elementValidator(ctx, op, elementPath, foundNewElementPtr, foundOldElementPtr)...)
+	}
+	return errs
+}
+
+// findListMapElementByKeyValues returns a pointer to the first element of
+// list whose keyNames[i] field equals keyValues[i] for every i, or nil if
+// list is empty or has no such element.
+func findListMapElementByKeyValues[TList ~[]TItem, TItem any](list TList, keyNames, keyValues []string) *TItem {
+	for i := range list {
+		val := reflect.ValueOf(list[i])
+		if val.Kind() != reflect.Struct {
+			continue
+		}
+		matched := true
+		for k := range keyNames {
+			fieldStrValue, ok := getReflectedJSONFieldValueAsString(val, keyNames[k])
+			if !ok || fieldStrValue != keyValues[k] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return &list[i]
+		}
+	}
+	return nil
+}
+
 // getReflectedJSONFieldValueAsString gets the string value of a field `jsonKeyName` from a struct `sVal`.
 // sVal must be a reflect.Value of Kind reflect.Struct.
 func getReflectedJSONFieldValueAsString(sVal reflect.Value, jsonKeyName string) (string, bool) {
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type subfieldPortItem struct {
+	Port     string `json:"port"`
+	Protocol string `json:"protocol"`
+	Name     string `json:"name"`
+}
+
+func TestListMapElementByKeyValues(t *testing.T) {
+	path := field.NewPath("ports")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	newList := []subfieldPortItem{
+		{Port: "80", Protocol: "TCP", Name: "new"},
+		{Port: "443", Protocol: "TCP", Name: "unrelated"},
+	}
+	oldList := []subfieldPortItem{
+		{Port: "80", Protocol: "TCP", Name: "old"},
+	}
+
+	var gotPath *field.Path
+	var gotNew, gotOld *subfieldPortItem
+	errs := ListMapElementByKeyValues(ctx, op, path, newList, oldList, []string{"port", "protocol"}, []string{"80", "TCP"},
+		func(_ context.Context, _ operation.Operation, fldPath *field.Path, newObj, oldObj *subfieldPortItem) field.ErrorList {
+			gotPath, gotNew, gotOld = fldPath, newObj, oldObj
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if want := `ports[port=80,protocol=TCP]`; gotPath == nil || gotPath.String() != want {
+		t.Errorf("expected path %q, got %v", want, gotPath)
+	}
+	if gotNew == nil || gotNew.Name != "new" {
+		t.Errorf("expected matched new item with Name %q, got %v", "new", gotNew)
+	}
+	if gotOld == nil || gotOld.Name != "old" {
+		t.Errorf("expected matched old item with Name %q, got %v", "old", gotOld)
+	}
+}
+
+func TestListMapElementByKeyValuesNoMatch(t *testing.T) {
+	path := field.NewPath("ports")
+	ctx := context.Background()
+	op := operation.Operation{Type: operation.Update}
+
+	list := []subfieldPortItem{{Port: "80", Protocol: "TCP", Name: "x"}}
+
+	called := false
+	errs := ListMapElementByKeyValues(ctx, op, path, list, list, []string{"port", "protocol"}, []string{"80", "UDP"},
+		func(_ context.Context, _ operation.Operation, _ *field.Path, _, _ *subfieldPortItem) field.ErrorList {
+			called = true
+			return nil
+		})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if called {
+		t.Errorf("expected elementValidator not to be called when not every key matches")
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ImmutableOnSubresourceByReflect forbids any change to the field read from
+// newObj/oldObj via getField during an update, using reflect-based equality
+// (see reflectEqual). It is semantically identical to FrozenByReflect, but
+// takes struct accessors rather than the field value directly, so it can be
+// generated once per non-allowed field of a struct from a single
+// type-level marker, the same way EqField/NeField are generated per
+// declared field pair from +k8s:eqField/+k8s:neField. It carries its own
+// "immutable-on-subresource" origin so these subresource-implied field
+// locks are distinguishable from the ordinary whole-lifecycle
+// k8s:frozen/k8s:immutable tags.
+func ImmutableOnSubresourceByReflect[T any, F any](_ context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *T, fieldName string, getField func(*T) *F) field.ErrorList {
+	if op.Type != operation.Update || oldObj == nil {
+		return nil
+	}
+	newV, oldV := getField(newObj), getField(oldObj)
+	if newV == nil && oldV == nil {
+		return nil
+	}
+	if newV == nil || oldV == nil || !reflectEqual(*newV, *oldV) {
+		return field.ErrorList{
+			field.Forbidden(fldPath.Child(fieldName), "may not be changed via this subresource").WithOrigin("immutable-on-subresource"),
+		}
+	}
+	return nil
+}
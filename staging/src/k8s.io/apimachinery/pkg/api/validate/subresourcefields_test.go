@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type subresourceFieldsTestObj struct {
+	Name string
+}
+
+func TestImmutableOnSubresourceByReflect(t *testing.T) {
+	path := field.NewPath("test")
+	getName := func(o *subresourceFieldsTestObj) *string { return &o.Name }
+
+	newObj := &subresourceFieldsTestObj{Name: "a"}
+	oldObj := &subresourceFieldsTestObj{Name: "a"}
+	if errs := ImmutableOnSubresourceByReflect(context.Background(), operation.Operation{Type: operation.Update}, path, newObj, oldObj, "name", getName); len(errs) != 0 {
+		t.Errorf("expected no errors for an unchanged field, got %s", fmtErrs(errs))
+	}
+
+	changed := &subresourceFieldsTestObj{Name: "b"}
+	errs := ImmutableOnSubresourceByReflect(context.Background(), operation.Operation{Type: operation.Update}, path, changed, oldObj, "name", getName)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error for a changed field, got %d: %s", len(errs), fmtErrs(errs))
+	}
+
+	if errs := ImmutableOnSubresourceByReflect(context.Background(), operation.Operation{Type: operation.Create}, path, changed, oldObj, "name", getName); len(errs) != 0 {
+		t.Errorf("expected no errors for a create operation, got %s", fmtErrs(errs))
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxSuggestions bounds how many candidates are named in a single "did you
+// mean" phrase, so a large dictionary doesn't produce an unreadable error.
+const maxSuggestions = 3
+
+// suggest computes a "did you mean X, Y, or Z?" phrase for input against
+// candidates, or "" if nothing is close enough to be worth suggesting.
+// Candidates are scored by Levenshtein edit distance; any candidate farther
+// than max(len(input)/2, len(candidate)/2, 1) is discarded, the rest are
+// sorted nearest-first, and the closest maxSuggestions are named.
+func suggest(input string, candidates []string) string {
+	type scored struct {
+		value    string
+		distance int
+	}
+	var matches []scored
+	for _, c := range candidates {
+		d := levenshteinDistance(input, c)
+		if d == 0 {
+			// input already equals this candidate; nothing to suggest.
+			continue
+		}
+		threshold := max(len(input)/2, len(c)/2, 1)
+		if d <= threshold {
+			matches = append(matches, scored{c, d})
+		}
+	}
+	if len(matches) == 0 {
+		return ""
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].distance < matches[j].distance })
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.value
+	}
+	return "did you mean " + joinOr(names) + "?"
+}
+
+// joinOr joins items into an English list using commas and a final "or",
+// quoting each item: {"a"} -> `"a"`, {"a","b"} -> `"a" or "b"`,
+// {"a","b","c"} -> `"a", "b", or "c"`.
+func joinOr(items []string) string {
+	quoted := make([]string, len(items))
+	for i, it := range items {
+		quoted[i] = fmt.Sprintf("%q", it)
+	}
+	switch len(quoted) {
+	case 1:
+		return quoted[0]
+	case 2:
+		return quoted[0] + " or " + quoted[1]
+	default:
+		return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+	}
+}
+
+// levenshteinDistance computes the classic single-character
+// insert/delete/substitute edit distance between a and b, operating on
+// runes so multi-byte characters count as one edit each.
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// WithSuggestions wraps a string validator so that, if it rejects the value,
+// the resulting error(s) get a "did you mean X?" suggestion appended to
+// Detail when one of candidates is a close enough match. Origin and Type are
+// left untouched, so callers matching on those (e.g. field.ErrorMatcher's
+// ByOrigin) are unaffected by whether a suggestion was found.
+func WithSuggestions(validator func(context.Context, operation.Operation, *field.Path, *string, *string) field.ErrorList, candidates []string) func(context.Context, operation.Operation, *field.Path, *string, *string) field.ErrorList {
+	return func(ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *string) field.ErrorList {
+		errs := validator(ctx, op, fldPath, value, oldValue)
+		if len(errs) == 0 || value == nil {
+			return errs
+		}
+		s := suggest(*value, candidates)
+		if s == "" {
+			return errs
+		}
+		for _, e := range errs {
+			if e.Detail == "" {
+				e.Detail = s
+			} else {
+				e.Detail = e.Detail + "; " + s
+			}
+		}
+		return errs
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"valid-name", "vaild-name", 2},
+	}
+	for _, c := range cases {
+		if got := levenshteinDistance(c.a, c.b); got != c.want {
+			t.Errorf("levenshteinDistance(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"production", "staging", "development"}
+
+	if got := suggest("productoin", candidates); got == "" {
+		t.Errorf("expected a suggestion for a near-miss typo, got none")
+	}
+
+	if got := suggest("production", candidates); got != "" {
+		t.Errorf("expected no suggestion for an exact match, got %q", got)
+	}
+
+	if got := suggest("zzzzzzzzzzzzzzzzzzzz", candidates); got != "" {
+		t.Errorf("expected no suggestion for a wildly different input, got %q", got)
+	}
+}
+
+func TestSuggestTruncatesToMaxSuggestions(t *testing.T) {
+	got := suggest("xat", []string{"cat", "bat", "hat", "mat"})
+	if got == "" {
+		t.Fatalf("expected a suggestion")
+	}
+	n := 0
+	for _, r := range got {
+		if r == '"' {
+			n++
+		}
+	}
+	if n != maxSuggestions*2 {
+		t.Errorf("expected exactly %d suggestions, got phrase: %q", maxSuggestions, got)
+	}
+}
+
+func TestWithSuggestions(t *testing.T) {
+	path := field.NewPath("test")
+	candidates := []string{"production"}
+
+	wrapped := WithSuggestions(ShortName, candidates)
+
+	val := "valid-name"
+	if errs := wrapped(context.Background(), operation.Operation{}, path, &val, nil); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	bad := "production!"
+	errs := wrapped(context.Background(), operation.Operation{}, path, &bad, nil)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if errs[0].Origin != "format=k8s-short-name" {
+		t.Errorf("expected origin to be left unchanged, got %q", errs[0].Origin)
+	}
+	if !strings.Contains(errs[0].Detail, `"production"`) {
+		t.Errorf("expected a suggestion in Detail, got %q", errs[0].Detail)
+	}
+}
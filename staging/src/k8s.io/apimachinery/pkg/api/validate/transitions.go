@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Transition describes one allowed (From, To) state change for
+// TransitionsByCompare / TransitionsByReflect. FromAny/ToAny make the
+// corresponding endpoint a wildcard ("*") that matches any value.
+// FromUnset/ToUnset make the endpoint match only the "unset" state (the
+// type's zero value, as determined by isUnsetComparable / isUnsetForReflect)
+// regardless of From/To.
+type Transition[T any] struct {
+	From, To           T
+	FromAny, ToAny     bool
+	FromUnset, ToUnset bool
+}
+
+// TransitionsByCompare verifies that, on update, a field's value only ever
+// changes along one of the allowed transitions. It does nothing for create
+// operations or when the value is unchanged. If the caller needs to compare
+// types that are not trivially comparable, use TransitionsByReflect instead.
+// Semantics:
+//   - An empty transitions list forbids ALL transitions, equivalent to FrozenByCompare.
+//   - A single {FromUnset: true, ToAny: true} entry allows only unset->set, equivalent to ImmutableValueByCompare.
+func TransitionsByCompare[T comparable](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *T, transitions []Transition[T]) field.ErrorList {
+	if op.Type != operation.Update {
+		return nil
+	}
+	var oldV, newV T
+	if oldValue != nil {
+		oldV = *oldValue
+	}
+	if value != nil {
+		newV = *value
+	}
+	if oldV == newV {
+		return nil
+	}
+	for _, t := range transitions {
+		if !matchesCompareEndpoint(oldV, t.From, t.FromAny, t.FromUnset) {
+			continue
+		}
+		if !matchesCompareEndpoint(newV, t.To, t.ToAny, t.ToUnset) {
+			continue
+		}
+		return nil
+	}
+	return field.ErrorList{
+		field.Forbidden(fldPath, fmt.Sprintf("transition %v -> %v is not allowed", oldV, newV)),
+	}
+}
+
+func matchesCompareEndpoint[T comparable](value, endpoint T, isAny, isUnset bool) bool {
+	switch {
+	case isAny:
+		return true
+	case isUnset:
+		return isUnsetComparable(&value)
+	default:
+		return value == endpoint
+	}
+}
+
+// TransitionsByReflect is TransitionsByCompare for types that are not
+// directly comparable, at the cost of performance.
+func TransitionsByReflect[T any](_ context.Context, op operation.Operation, fldPath *field.Path, value, oldValue T, transitions []Transition[T]) field.ErrorList {
+	if op.Type != operation.Update {
+		return nil
+	}
+	if equality.Semantic.DeepEqual(value, oldValue) {
+		return nil
+	}
+	for _, t := range transitions {
+		if !matchesReflectEndpoint(oldValue, t.From, t.FromAny, t.FromUnset) {
+			continue
+		}
+		if !matchesReflectEndpoint(value, t.To, t.ToAny, t.ToUnset) {
+			continue
+		}
+		return nil
+	}
+	return field.ErrorList{
+		field.Forbidden(fldPath, fmt.Sprintf("transition %v -> %v is not allowed", oldValue, value)),
+	}
+}
+
+func matchesReflectEndpoint[T any](value, endpoint T, isAny, isUnset bool) bool {
+	switch {
+	case isAny:
+		return true
+	case isUnset:
+		return isUnsetForReflect(value)
+	default:
+		return reflect.DeepEqual(value, endpoint)
+	}
+}
+
+// TransitionAllowed verifies that, on update, a field's value only changes
+// along an edge present in allowed: allowed[from] lists every state
+// directly reachable from from. A nil oldValue is treated as an "initial"
+// pseudo-state that isn't a key in allowed -- the same "create is always
+// allowed" rule TransitionsByCompare applies to a create operation extends
+// here to an update that's initializing a previously-unset field, since
+// there's no well-defined "from" state to look up.
+//
+// allowed reads as a state diagram (e.g. {Pending: {Running}, Running:
+// {Succeeded, Failed}}) more directly than TransitionsByCompare's
+// unordered []Transition[T] pair list does, at the cost of not supporting
+// wildcard/unset endpoints; TransitionAllowed is implemented in terms of
+// TransitionsByCompare rather than duplicating its comparison logic. A
+// from-state listed with an empty to-list is treated the same as a from-state
+// absent from allowed entirely: both are terminal states with no allowed
+// outgoing transitions.
+func TransitionAllowed[T comparable](ctx context.Context, op operation.Operation, fldPath *field.Path, value, oldValue *T, allowed map[T][]T) field.ErrorList {
+	if op.Type != operation.Update || oldValue == nil {
+		return nil
+	}
+	if value != nil && *value == *oldValue {
+		return nil
+	}
+	tos, known := allowed[*oldValue]
+	if !known || len(tos) == 0 {
+		return field.ErrorList{
+			field.Invalid(fldPath, derefOrZero(value), fmt.Sprintf("no transitions are defined from state %v", *oldValue)),
+		}
+	}
+	transitions := make([]Transition[T], 0, len(tos))
+	for _, to := range tos {
+		transitions = append(transitions, Transition[T]{From: *oldValue, To: to})
+	}
+	if errs := TransitionsByCompare(ctx, op, fldPath, value, oldValue, transitions); len(errs) > 0 {
+		return field.ErrorList{
+			field.Invalid(fldPath, derefOrZero(value), fmt.Sprintf("transition from %v is only allowed to one of %v", *oldValue, tos)),
+		}
+	}
+	return nil
+}
+
+// derefOrZero returns *v, or T's zero value if v is nil.
+func derefOrZero[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestTransitionsByCompare(t *testing.T) {
+	path := field.NewPath("test")
+	transitions := []Transition[string]{
+		{FromUnset: true, To: "Pending"},
+		{From: "Pending", To: "Running"},
+		{From: "Running", ToAny: true},
+	}
+
+	unset, pending, running, failed := "", "Pending", "Running", "Failed"
+
+	for _, tc := range []struct {
+		name          string
+		value, oldVal *string
+		wantErr       bool
+	}{
+		{"create is never checked", &running, nil, false},
+		{"no change", &pending, &pending, false},
+		{"unset to pending is allowed", &pending, &unset, false},
+		{"pending to running is allowed", &running, &pending, false},
+		{"running to anything is allowed", &failed, &running, false},
+		{"pending to failed is not allowed", &failed, &pending, true},
+		{"unset to running is not allowed", &running, &unset, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			op := operation.Operation{Type: operation.Update}
+			if tc.oldVal == nil {
+				op = operation.Operation{Type: operation.Create}
+			}
+			errs := TransitionsByCompare(context.Background(), op, path, tc.value, tc.oldVal, transitions)
+			if tc.wantErr && len(errs) == 0 {
+				t.Errorf("expected an error, got none")
+			}
+			if !tc.wantErr && len(errs) != 0 {
+				t.Errorf("expected no errors, got %s", fmtErrs(errs))
+			}
+		})
+	}
+}
+
+func TestTransitionsByCompareEmptyIsFrozen(t *testing.T) {
+	path := field.NewPath("test")
+	a, b := "a", "b"
+	errs := TransitionsByCompare(context.Background(), operation.Operation{Type: operation.Update}, path, &b, &a, nil)
+	if len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestTransitionAllowed(t *testing.T) {
+	path := field.NewPath("test")
+	allowed := map[string][]string{
+		"Pending":   {"Running"},
+		"Running":   {"Succeeded", "Failed"},
+		"Succeeded": {"Succeeded"},
+		"Terminal":  {},
+	}
+
+	pending, running, succeeded, failed, unknown, terminal := "Pending", "Running", "Succeeded", "Failed", "Unknown", "Terminal"
+
+	for _, tc := range []struct {
+		name          string
+		value, oldVal *string
+		wantErr       bool
+	}{
+		{"create is always allowed", &running, nil, false},
+		{"no change", &pending, &pending, false},
+		{"pending to running is allowed", &running, &pending, false},
+		{"running to succeeded is allowed", &succeeded, &running, false},
+		{"running to failed is allowed", &failed, &running, false},
+		{"succeeded self-loop is allowed", &succeeded, &succeeded, false},
+		{"pending to failed is not allowed", &failed, &pending, true},
+		{"failed has no outgoing transitions defined", &running, &failed, true},
+		{"unknown current state has no transitions defined", &running, &unknown, true},
+		{"terminal state listed with an empty to-list has no outgoing transitions defined", &running, &terminal, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			op := operation.Operation{Type: operation.Update}
+			if tc.oldVal == nil {
+				op = operation.Operation{Type: operation.Create}
+			}
+			errs := TransitionAllowed(context.Background(), op, path, tc.value, tc.oldVal, allowed)
+			if tc.wantErr && len(errs) == 0 {
+				t.Errorf("expected an error, got none")
+			}
+			if !tc.wantErr && len(errs) != 0 {
+				t.Errorf("expected no errors, got %s", fmtErrs(errs))
+			}
+		})
+	}
+}
+
+func TestTransitionsByReflect(t *testing.T) {
+	path := field.NewPath("test")
+	transitions := []Transition[StructComparable]{
+		{FromUnset: true, ToAny: true},
+	}
+
+	zero := StructComparable{}
+	a := StructComparable{S: "a"}
+	b := StructComparable{S: "b"}
+
+	if errs := TransitionsByReflect(context.Background(), operation.Operation{Type: operation.Update}, path, a, zero, transitions); len(errs) != 0 {
+		t.Errorf("expected no errors for unset->set, got %s", fmtErrs(errs))
+	}
+	if errs := TransitionsByReflect(context.Background(), operation.Operation{Type: operation.Update}, path, b, a, transitions); len(errs) != 1 {
+		t.Errorf("expected 1 error for set->set, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if errs := TransitionsByReflect(context.Background(), operation.Operation{Type: operation.Create}, path, b, a, transitions); len(errs) != 0 {
+		t.Errorf("expected no errors on create, got %s", fmtErrs(errs))
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// UnionDiscriminator validates that value, a discriminator field selecting
+// between a set of sibling +k8s:unionMember fields (e.g. VolumeSource's
+// "type"), names one of the declared member values. A nil value (the field
+// itself absent) is not checked here; pair with +k8s:required if the
+// discriminator must always be set.
+func UnionDiscriminator(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string, allowed []string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	for _, a := range allowed {
+		if *value == a {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotSupported(fldPath, *value, formatSortedValues(allowed)).WithOrigin("k8s:unionDiscriminator")}
+}
+
+// UnionMember validates that value -- a member field of a discriminated
+// union -- is set if and only if the sibling discriminator field equals
+// wantValue. A nil discriminator (the discriminator field itself unset)
+// never selects this member. Pair with +k8s:unionDiscriminator on the
+// discriminator field to also reject values that name no member at all.
+func UnionMember[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, value T, discriminator *string, wantValue, discriminatorFieldName string) field.ErrorList {
+	selected := discriminator != nil && *discriminator == wantValue
+	isSet := !isUnsetForReflect(value)
+	switch {
+	case selected && !isSet:
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("must be set when %s is %q", discriminatorFieldName, wantValue)).WithOrigin("k8s:unionMember")}
+	case !selected && isSet:
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("must not be set unless %s is %q", discriminatorFieldName, wantValue)).WithOrigin("k8s:unionMember")}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestUnionDiscriminator(t *testing.T) {
+	allowed := []string{"EmptyDir", "HostPath"}
+	path := field.NewPath("type")
+
+	if errs := UnionDiscriminator(context.Background(), operation.Operation{}, path, ptr.To("EmptyDir"), nil, allowed); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+	if errs := UnionDiscriminator(context.Background(), operation.Operation{}, path, ptr.To("Bogus"), nil, allowed); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+	if errs := UnionDiscriminator(context.Background(), operation.Operation{}, path, nil, nil, allowed); len(errs) != 0 {
+		t.Errorf("expected no errors for nil value, got %s", fmtErrs(errs))
+	}
+}
+
+func TestUnionMember(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		value         *string
+		discriminator *string
+		fail          bool
+	}{{
+		name:          "selected and set",
+		value:         ptr.To("/mnt/data"),
+		discriminator: ptr.To("HostPath"),
+	}, {
+		name:          "selected but unset",
+		value:         nil,
+		discriminator: ptr.To("HostPath"),
+		fail:          true,
+	}, {
+		name:          "not selected and unset",
+		value:         nil,
+		discriminator: ptr.To("EmptyDir"),
+	}, {
+		name:          "not selected but set",
+		value:         ptr.To("/mnt/data"),
+		discriminator: ptr.To("EmptyDir"),
+		fail:          true,
+	}, {
+		name:          "discriminator unset, member unset",
+		value:         nil,
+		discriminator: nil,
+	}, {
+		name:          "discriminator unset, member set",
+		value:         ptr.To("/mnt/data"),
+		discriminator: nil,
+		fail:          true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := UnionMember(context.Background(), operation.Operation{}, field.NewPath("hostPath"), tc.value, tc.discriminator, "HostPath", "type")
+			if tc.fail && len(errs) == 0 {
+				t.Errorf("expected failure")
+			} else if !tc.fail && len(errs) != 0 {
+				t.Errorf("expected success: %s", fmtErrs(errs))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// UniqueItems validates that list has no duplicate elements, using an O(n)
+// map[T]struct{} for duplicate detection. T must be comparable; for lists of
+// structs that aren't directly comparable, use UniqueItemsByKey instead.
+func UniqueItems[T comparable](_ context.Context, _ operation.Operation, fldPath *field.Path, list, _ []T) field.ErrorList {
+	seen := make(map[T]struct{}, len(list))
+	for i, v := range list {
+		if _, dup := seen[v]; dup {
+			return field.ErrorList{field.Duplicate(fldPath.Index(i), v).WithOrigin("k8s:uniqueItems")}
+		}
+		seen[v] = struct{}{}
+	}
+	return nil
+}
+
+// UniqueItemsByKey validates that list has no two elements with the same
+// key, as computed by the key function. It is the +k8s:listMapKey-aware
+// fallback for lists of structs, where the items themselves aren't directly
+// comparable but one or more fields identify them uniquely.
+func UniqueItemsByKey[T any](_ context.Context, _ operation.Operation, fldPath *field.Path, list, _ []T, key func(*T) string) field.ErrorList {
+	seen := make(map[string]struct{}, len(list))
+	for i := range list {
+		k := key(&list[i])
+		if _, dup := seen[k]; dup {
+			return field.ErrorList{field.Duplicate(fldPath.Index(i), k).WithOrigin("k8s:uniqueItems")}
+		}
+		seen[k] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestUniqueItems(t *testing.T) {
+	path := field.NewPath("test")
+
+	if errs := UniqueItems(context.Background(), operation.Operation{}, path, []string{"a", "b", "c"}, nil); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	if errs := UniqueItems(context.Background(), operation.Operation{}, path, []string{"a", "b", "a"}, nil); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
+
+func TestUniqueItemsByKey(t *testing.T) {
+	type item struct {
+		Name string
+	}
+	key := func(i *item) string { return i.Name }
+	path := field.NewPath("test")
+
+	list := []item{{Name: "a"}, {Name: "b"}}
+	if errs := UniqueItemsByKey(context.Background(), operation.Operation{}, path, list, nil, key); len(errs) != 0 {
+		t.Errorf("expected no errors, got %s", fmtErrs(errs))
+	}
+
+	dup := []item{{Name: "a"}, {Name: "a"}}
+	if errs := UniqueItemsByKey(context.Background(), operation.Operation{}, path, dup, nil, key); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %d: %s", len(errs), fmtErrs(errs))
+	}
+}
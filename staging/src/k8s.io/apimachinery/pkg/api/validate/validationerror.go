@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import "k8s.io/apimachinery/pkg/util/validation/field"
+
+// ValidationError is a structured sibling of *field.Error: every generated
+// validator already tags its *field.Error with an Origin (e.g. "k8s:minimum",
+// "k8s:eqOneOf", via .WithOrigin) identifying which tag produced it, but
+// field.Error itself has no room for a more granular machine-readable Reason,
+// the set of gating Conditions (e.g. which +k8s:ifOptionEnabled option had to
+// be on for this check to even run) a caller would need to reproduce or
+// explain the failure programmatically, or the Params (limit value, allowed
+// set, key name/value, ...) the message was built from. Rather than forking
+// field.Error to add them, ValidationError wraps one, carrying that
+// additional structure alongside it for a consumer -- an admission webhook,
+// `kubectl explain`, a docs generator -- that wants to consume a failure
+// programmatically instead of parsing Detail strings.
+type ValidationError struct {
+	// Err is the underlying field.Error this wraps, unmodified; Origin
+	// (already set by the validator that produced Err) remains the
+	// authoritative "which tag" identifier. ValidationError only adds to
+	// it, never duplicates or overrides it.
+	Err *field.Error
+
+	// Reason is a machine-readable code finer-grained than Origin, e.g.
+	// distinguishing "BelowMinimum" from "AboveMaximum" for two failures
+	// that might otherwise share an Origin (a single k8s:range-style tag
+	// producing either, say).
+	Reason string
+
+	// Conditions records the gating conditions that had to hold for this
+	// check to run at all, e.g. {"option": "MyFeatureOption"} for a check
+	// nested under +k8s:ifOptionEnabled(MyFeatureOption), or
+	// {"featureGate": "MyFeatureGate"} for +k8s:ifFeatureEnabled. Nil when
+	// the check that produced Err was unconditional.
+	Conditions map[string]string
+
+	// Params holds the structured values the error's message was rendered
+	// from -- e.g. {"limit": 10} for a k8s:maximum failure, or
+	// {"keyName": "type", "keyValue": "Ready"} for a list-map element
+	// mismatch -- so a consumer can reconstruct or localize the message
+	// without parsing Err.Detail.
+	Params map[string]any
+}
+
+// NewValidationError wraps err as a ValidationError with reason and no
+// conditions or params set; use WithCondition/WithParam to add them.
+func NewValidationError(err *field.Error, reason string) *ValidationError {
+	return &ValidationError{Err: err, Reason: reason}
+}
+
+// WithCondition records that key/value was one of the gating conditions
+// under which this error's check ran, and returns ve for chaining.
+func (ve *ValidationError) WithCondition(key, value string) *ValidationError {
+	if ve.Conditions == nil {
+		ve.Conditions = map[string]string{}
+	}
+	ve.Conditions[key] = value
+	return ve
+}
+
+// WithParam records one of the structured values the error's message was
+// built from, and returns ve for chaining.
+func (ve *ValidationError) WithParam(name string, value any) *ValidationError {
+	if ve.Params == nil {
+		ve.Params = map[string]any{}
+	}
+	ve.Params[name] = value
+	return ve
+}
+
+// Origin returns the wrapped field.Error's Origin -- the tag name (e.g.
+// "k8s:minimum") that produced it -- or "" if Err is nil.
+func (ve *ValidationError) Origin() string {
+	if ve.Err == nil {
+		return ""
+	}
+	return ve.Err.Origin
+}
+
+// Error implements the error interface by delegating to the wrapped
+// field.Error, so a ValidationError can be used anywhere a plain error is
+// accepted without losing its field.Error-formatted message.
+func (ve *ValidationError) Error() string {
+	if ve.Err == nil {
+		return ""
+	}
+	return ve.Err.Error()
+}
+
+// AsValidationErrors wraps every error in errs as a ValidationError, copying
+// each one's existing Origin and tagging conditions onto every result -- the
+// conditions a caller observed gated the whole ErrorList, e.g. the option
+// name an +k8s:ifOptionEnabled-gated validation ran under. Reason is left
+// empty; a caller with a finer-grained reason code for a specific error
+// should construct that one via NewValidationError/WithCondition directly
+// instead.
+func AsValidationErrors(errs field.ErrorList, conditions map[string]string) []*ValidationError {
+	if len(errs) == 0 {
+		return nil
+	}
+	out := make([]*ValidationError, len(errs))
+	for i, err := range errs {
+		ve := NewValidationError(err, "")
+		for k, v := range conditions {
+			ve.WithCondition(k, v)
+		}
+		out[i] = ve
+	}
+	return out
+}
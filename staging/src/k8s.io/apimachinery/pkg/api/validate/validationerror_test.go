@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestNewValidationError(t *testing.T) {
+	fieldErr := field.Invalid(field.NewPath("spec", "replicas"), 10, "must be <= 5").WithOrigin("k8s:maximum")
+
+	ve := NewValidationError(fieldErr, "AboveMaximum").
+		WithCondition("option", "MyOption").
+		WithParam("limit", 5)
+
+	if ve.Origin() != "k8s:maximum" {
+		t.Errorf("expected origin %q, got %q", "k8s:maximum", ve.Origin())
+	}
+	if ve.Reason != "AboveMaximum" {
+		t.Errorf("expected reason %q, got %q", "AboveMaximum", ve.Reason)
+	}
+	if got := ve.Conditions["option"]; got != "MyOption" {
+		t.Errorf("expected condition option=%q, got %q", "MyOption", got)
+	}
+	if got := ve.Params["limit"]; got != 5 {
+		t.Errorf("expected param limit=5, got %v", got)
+	}
+	if ve.Error() != fieldErr.Error() {
+		t.Errorf("expected Error() to delegate to the wrapped field.Error, got %q, want %q", ve.Error(), fieldErr.Error())
+	}
+}
+
+func TestValidationErrorNilErr(t *testing.T) {
+	ve := NewValidationError(nil, "")
+	if ve.Origin() != "" {
+		t.Errorf("expected empty origin for a nil wrapped error, got %q", ve.Origin())
+	}
+	if ve.Error() != "" {
+		t.Errorf("expected empty Error() for a nil wrapped error, got %q", ve.Error())
+	}
+}
+
+func TestAsValidationErrors(t *testing.T) {
+	path := field.NewPath("spec", "replicas")
+	errs := field.ErrorList{
+		field.Invalid(path, 10, "must be <= 5").WithOrigin("k8s:maximum"),
+		field.Invalid(path, 10, "must be a multiple of 2").WithOrigin("k8s:multipleOf"),
+	}
+
+	ves := AsValidationErrors(errs, map[string]string{"option": "MyOption"})
+	if len(ves) != 2 {
+		t.Fatalf("expected 2 ValidationErrors, got %d", len(ves))
+	}
+	for i, ve := range ves {
+		if ve.Err != errs[i] {
+			t.Errorf("expected wrapped error %d to be errs[%d] unchanged", i, i)
+		}
+		if got := ve.Conditions["option"]; got != "MyOption" {
+			t.Errorf("expected condition option=%q on result %d, got %q", "MyOption", i, got)
+		}
+	}
+	if ves[0].Origin() != "k8s:maximum" || ves[1].Origin() != "k8s:multipleOf" {
+		t.Errorf("expected each ValidationError to keep its own Origin, got %q and %q", ves[0].Origin(), ves[1].Origin())
+	}
+}
+
+func TestAsValidationErrorsEmpty(t *testing.T) {
+	if got := AsValidationErrors(nil, nil); got != nil {
+		t.Errorf("expected nil for an empty ErrorList, got %v", got)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+// DiffKind categorizes a single structural divergence found by EqualWithDiff.
+type DiffKind int
+
+const (
+	// DiffModified means the same path exists in both values but compares unequal.
+	DiffModified DiffKind = iota
+	// DiffAdded means the path exists in the right-hand value only.
+	DiffAdded
+	// DiffRemoved means the path exists in the left-hand value only.
+	DiffRemoved
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case DiffAdded:
+		return "added"
+	case DiffRemoved:
+		return "removed"
+	default:
+		return "modified"
+	}
+}
+
+// DiffStep records one field, slice-index, or map-key level divergence found
+// while walking two ref.Val trees produced by TypedToVal.
+type DiffStep struct {
+	Path     string
+	Kind     DiffKind
+	LHS, RHS any
+}
+
+// String renders a DiffStep in the form ".x.y[2].name: "foo" != "bar"" for
+// DiffModified, or ".x.y[2]: added "foo"" / ".x.y[2]: removed "foo"" for
+// DiffAdded/DiffRemoved.
+func (d DiffStep) String() string {
+	switch d.Kind {
+	case DiffAdded:
+		return fmt.Sprintf("%s: added %q", d.Path, fmt.Sprint(d.RHS))
+	case DiffRemoved:
+		return fmt.Sprintf("%s: removed %q", d.Path, fmt.Sprint(d.LHS))
+	default:
+		return fmt.Sprintf("%s: %q != %q", d.Path, fmt.Sprint(d.LHS), fmt.Sprint(d.RHS))
+	}
+}
+
+// EqualWithDiff reports whether a and b (both produced by TypedToVal) are
+// CEL-equal and, if not, the ordered list of structural differences that
+// caused the mismatch. Structs are walked by JSON field name, slices by
+// index, and maps by key -- the same traversal TypedToVal itself uses to
+// build structVal/sliceVal/mapVal.
+func EqualWithDiff(a, b ref.Val) (bool, []DiffStep) {
+	var steps []DiffStep
+	walkDiff("", a, b, &steps)
+	return len(steps) == 0, steps
+}
+
+func walkDiff(path string, a, b ref.Val, steps *[]DiffStep) {
+	switch av := a.(type) {
+	case *structVal:
+		bv, ok := b.(*structVal)
+		if !ok {
+			*steps = append(*steps, DiffStep{Path: path, Kind: DiffModified, LHS: refValInterface(a), RHS: refValInterface(b)})
+			return
+		}
+		walkStructDiff(path, av, bv, steps)
+	case *sliceVal:
+		bv, ok := b.(*sliceVal)
+		if !ok {
+			*steps = append(*steps, DiffStep{Path: path, Kind: DiffModified, LHS: refValInterface(a), RHS: refValInterface(b)})
+			return
+		}
+		walkSliceDiff(path, av, bv, steps)
+	case *mapVal:
+		bv, ok := b.(*mapVal)
+		if !ok {
+			*steps = append(*steps, DiffStep{Path: path, Kind: DiffModified, LHS: refValInterface(a), RHS: refValInterface(b)})
+			return
+		}
+		walkMapDiff(path, av, bv, steps)
+	default:
+		if eq, ok := a.Equal(b).(types.Bool); !ok || !bool(eq) {
+			*steps = append(*steps, DiffStep{Path: path, Kind: DiffModified, LHS: refValInterface(a), RHS: refValInterface(b)})
+		}
+	}
+}
+
+func walkStructDiff(path string, a, b *structVal, steps *[]DiffStep) {
+	names := fieldNames(a.value.Type())
+	for _, bName := range fieldNames(b.value.Type()) {
+		if _, ok := a.lookupField(types.String(bName)); !ok {
+			names = append(names, bName)
+		}
+	}
+	sort.Strings(names)
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		childPath := path + "." + name
+		aVal, aOk := a.lookupField(types.String(name))
+		bVal, bOk := b.lookupField(types.String(name))
+		switch {
+		case aOk && !bOk:
+			*steps = append(*steps, DiffStep{Path: childPath, Kind: DiffRemoved, LHS: refValInterface(aVal)})
+		case !aOk && bOk:
+			*steps = append(*steps, DiffStep{Path: childPath, Kind: DiffAdded, RHS: refValInterface(bVal)})
+		case aOk && bOk:
+			walkDiff(childPath, aVal, bVal, steps)
+		}
+	}
+}
+
+func walkSliceDiff(path string, a, b *sliceVal, steps *[]DiffStep) {
+	an, bn := a.value.Len(), b.value.Len()
+	n := an
+	if bn < n {
+		n = bn
+	}
+	for i := 0; i < n; i++ {
+		walkDiff(fmt.Sprintf("%s[%d]", path, i), a.Get(types.Int(i)), b.Get(types.Int(i)), steps)
+	}
+	for i := n; i < an; i++ {
+		*steps = append(*steps, DiffStep{Path: fmt.Sprintf("%s[%d]", path, i), Kind: DiffRemoved, LHS: refValInterface(a.Get(types.Int(i)))})
+	}
+	for i := n; i < bn; i++ {
+		*steps = append(*steps, DiffStep{Path: fmt.Sprintf("%s[%d]", path, i), Kind: DiffAdded, RHS: refValInterface(b.Get(types.Int(i)))})
+	}
+}
+
+func walkMapDiff(path string, a, b *mapVal, steps *[]DiffStep) {
+	keySet := make(map[string]ref.Val)
+	collectKeys := func(m *mapVal) {
+		for it := m.value.MapRange(); it.Next(); {
+			key := typedToVal(it.Key().Interface(), m.opts)
+			keySet[fmt.Sprint(refValInterface(key))] = key
+		}
+	}
+	collectKeys(a)
+	collectKeys(b)
+	labels := make([]string, 0, len(keySet))
+	for label := range keySet {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		childPath := fmt.Sprintf("%s[%q]", path, label)
+		key := keySet[label]
+		aVal, aOk := a.Find(key)
+		bVal, bOk := b.Find(key)
+		switch {
+		case aOk && !bOk:
+			*steps = append(*steps, DiffStep{Path: childPath, Kind: DiffRemoved, LHS: refValInterface(aVal)})
+		case !aOk && bOk:
+			*steps = append(*steps, DiffStep{Path: childPath, Kind: DiffAdded, RHS: refValInterface(bVal)})
+		default:
+			walkDiff(childPath, aVal, bVal, steps)
+		}
+	}
+}
+
+// fieldNames returns the JSON field names of a struct type, as tracked by
+// the same field cache structVal.lookupField uses.
+func fieldNames(t reflect.Type) []string {
+	fields := value.TypeReflectEntryOf(t).Fields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	return names
+}
+
+func refValInterface(v ref.Val) any {
+	if v == nil {
+		return nil
+	}
+	return v.Value()
+}
+
+// Diff returns a cel.EnvOption that registers a `diff(a, b)` CEL function,
+// returning a "; "-joined structural diff of a and b (empty string if they
+// are equal) via EqualWithDiff.
+func Diff() celgo.EnvOption {
+	return celgo.Function("diff",
+		celgo.Overload("diff_dyn_dyn", []*celgo.Type{celgo.DynType, celgo.DynType}, celgo.StringType,
+			celgo.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+				_, steps := EqualWithDiff(lhs, rhs)
+				lines := make([]string, 0, len(steps))
+				for _, s := range steps {
+					lines = append(lines, s.String())
+				}
+				return types.String(strings.Join(lines, "; "))
+			}),
+		),
+	)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// enumType is the registration record for one Go named-string enum type:
+// its own CEL type identity, and the two-way mapping between the symbolic
+// names rule authors will write (e.g. "EnumTypeA") and the underlying
+// string values a Go field of that type actually holds (e.g. "a").
+type enumType struct {
+	goType  reflect.Type
+	celType *types.Type
+	byName  map[string]string // symbolic name -> underlying value
+	byValue map[string]string // underlying value -> symbolic name
+}
+
+// enumRegistry holds every type registered via RegisterEnum.
+var enumRegistry sync.Map // map[reflect.Type]*enumType
+
+// RegisterEnum declares t -- a Go named string type such as
+// `type Protocol string` -- as a CEL enum: values maps each of t's
+// symbolic constant names, exactly as a rule author should write them
+// (e.g. "ProtocolTCP"), to the string value that constant holds (e.g.
+// "TCP"). Once registered, TypedToVal converts any field of type t into a
+// dedicated CEL value with its own type (so it no longer collapses into a
+// bare string), and EnumTypes registers each name as a CEL constant of
+// that type, so `x.protocol == ProtocolTCP` is checked at compile time:
+// a typo like ProtocolTpc fails to compile as an undeclared identifier,
+// instead of the bare string comparison `x.protocol == "Tpc"` that
+// compiles fine and just never matches.
+//
+// Register every enum type during package initialization, before any
+// value of that type is passed to TypedToVal: the per-type field cache
+// built by the first conversion of a struct containing t is never
+// invalidated, so a RegisterEnum call that arrives afterward would be
+// too late for that struct type.
+func RegisterEnum(t reflect.Type, values map[string]string) {
+	byValue := make(map[string]string, len(values))
+	for name, value := range values {
+		byValue[value] = name
+	}
+	enumRegistry.Store(t, &enumType{
+		goType:  t,
+		celType: types.NewOpaqueType(t.Name()),
+		byName:  values,
+		byValue: byValue,
+	})
+}
+
+// lookupEnumType returns the enumType registered for t, if any.
+func lookupEnumType(t reflect.Type) (*enumType, bool) {
+	v, ok := enumRegistry.Load(t)
+	if !ok {
+		return nil, false
+	}
+	return v.(*enumType), true
+}
+
+// newEnumVal returns value (et's underlying Go string value) as an
+// enumVal, or a CEL error if value isn't one of et's registered values --
+// which should only happen if a Go value of the enum's type was
+// constructed outside its declared constants.
+func newEnumVal(et *enumType, value string) ref.Val {
+	name, ok := et.byValue[value]
+	if !ok {
+		return types.NewErr("%q is not a recognized %s value", value, et.goType.Name())
+	}
+	return enumVal{et: et, name: name, value: value}
+}
+
+// enumVal adapts a registered Go enum value into a ref.Val with its own
+// CEL type: it compares case-sensitively equal to a plain CEL string
+// holding its underlying value (so `x.protocol == "TCP"` still works),
+// and equal to another enumVal of the same registered type holding the
+// same value, but is otherwise a distinct type from string -- letting
+// EnumTypes's symbolic constants (e.g. ProtocolTCP) type-check against it
+// at compile time instead of silently stringifying.
+type enumVal struct {
+	et    *enumType
+	name  string // symbolic name, e.g. "ProtocolTCP"
+	value string // underlying value, e.g. "TCP"
+}
+
+// String renders the symbolic name, not the underlying value, so an
+// enumVal reads as e.g. "ProtocolTCP" wherever Go's fmt package formats
+// one (error messages, test failures, ...).
+func (v enumVal) String() string {
+	return v.name
+}
+
+func (v enumVal) ConvertToNative(typeDesc reflect.Type) (interface{}, error) {
+	switch {
+	case typeDesc == v.et.goType:
+		return reflect.ValueOf(v.value).Convert(typeDesc).Interface(), nil
+	case typeDesc.Kind() == reflect.String:
+		return v.value, nil
+	default:
+		return nil, fmt.Errorf("type conversion error from '%s' to '%s'", v.Type().TypeName(), typeDesc)
+	}
+}
+
+func (v enumVal) ConvertToType(typeValue ref.Type) ref.Val {
+	switch typeValue {
+	case v.et.celType:
+		return v
+	case types.StringType:
+		return types.String(v.value)
+	case types.TypeType:
+		return v.et.celType
+	}
+	return types.NewErr("type conversion error from '%s' to '%s'", v.Type().TypeName(), typeValue.TypeName())
+}
+
+func (v enumVal) Equal(other ref.Val) ref.Val {
+	switch o := other.(type) {
+	case enumVal:
+		return types.Bool(v.et == o.et && v.value == o.value)
+	case types.String:
+		return types.Bool(v.value == string(o))
+	default:
+		return types.MaybeNoSuchOverloadErr(other)
+	}
+}
+
+func (v enumVal) Type() ref.Type {
+	return v.et.celType
+}
+
+func (v enumVal) Value() interface{} {
+	return v.value
+}
+
+// EnumTypes returns a cel.EnvOption declaring a CEL constant for every
+// symbolic name of each given Go enum type, so rules can reference them by
+// name (e.g. ProtocolTCP) instead of a bare, typo-prone string literal.
+// Every goType must already have been registered with RegisterEnum;
+// unregistered types are silently skipped, matching RegisterEnum's own
+// "register before use" contract.
+func EnumTypes(goTypes ...reflect.Type) celgo.EnvOption {
+	return celgo.Lib(enumTypesLib{goTypes: goTypes})
+}
+
+type enumTypesLib struct {
+	goTypes []reflect.Type
+}
+
+func (l enumTypesLib) CompileOptions() []celgo.EnvOption {
+	var opts []celgo.EnvOption
+	for _, t := range l.goTypes {
+		et, ok := lookupEnumType(t)
+		if !ok {
+			continue
+		}
+		for name, value := range et.byName {
+			opts = append(opts, celgo.Constant(name, et.celType, enumVal{et: et, name: name, value: value}))
+		}
+	}
+	return opts
+}
+
+func (enumTypesLib) ProgramOptions() []celgo.ProgramOption {
+	return nil
+}
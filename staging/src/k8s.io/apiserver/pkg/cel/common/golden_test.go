@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// update regenerates testdata/*.golden files from the live evaluation
+// results of the expressions they already contain, analogous to go-cmp's
+// mustParseGolden -update flag: run with
+//
+//	go test ./... -run TestTypedToVal -update
+//
+// after adding a new "<<< name" block (expression only, output left blank)
+// to fill in its expected output, or after a deliberate behavior change.
+var update = flag.Bool("update", false, "update testdata/*.golden files with live results")
+
+// goldenEntry is one "<<< name" ... ">>> name" ... block of a .golden file:
+// a named CEL expression and its expected result.
+type goldenEntry struct {
+	Name       string
+	Expression string
+	// Output is either "true" (expression must evaluate to true with no
+	// error) or "ERROR: <message>" (expression must fail to evaluate with
+	// exactly that error message).
+	Output string
+}
+
+// parseGolden parses the "<<< name\n<expr>\n>>> name\n<output>\n" block
+// format used by testdata/*.golden files.
+func parseGolden(data []byte) ([]goldenEntry, error) {
+	lines := strings.Split(string(data), "\n")
+	var entries []goldenEntry
+	i := 0
+	for i < len(lines) {
+		if strings.TrimSpace(lines[i]) == "" {
+			i++
+			continue
+		}
+		if !strings.HasPrefix(lines[i], "<<< ") {
+			return nil, fmt.Errorf("line %d: expected '<<< name', got %q", i+1, lines[i])
+		}
+		name := strings.TrimSpace(strings.TrimPrefix(lines[i], "<<< "))
+		i++
+
+		var exprLines []string
+		for i < len(lines) && !strings.HasPrefix(lines[i], ">>> ") {
+			exprLines = append(exprLines, lines[i])
+			i++
+		}
+		if i >= len(lines) {
+			return nil, fmt.Errorf("block %q: missing '>>> %s' terminator", name, name)
+		}
+		endName := strings.TrimSpace(strings.TrimPrefix(lines[i], ">>> "))
+		if endName != name {
+			return nil, fmt.Errorf("block %q: terminator names %q, want %q", name, endName, name)
+		}
+		i++
+
+		var outLines []string
+		for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+			outLines = append(outLines, lines[i])
+			i++
+		}
+
+		entries = append(entries, goldenEntry{
+			Name:       name,
+			Expression: strings.TrimSpace(strings.Join(exprLines, "\n")),
+			Output:     strings.TrimSpace(strings.Join(outLines, "\n")),
+		})
+	}
+	return entries, nil
+}
+
+// formatGolden is the inverse of parseGolden.
+func formatGolden(entries []goldenEntry) []byte {
+	var b strings.Builder
+	for _, e := range entries {
+		fmt.Fprintf(&b, "<<< %s\n%s\n>>> %s\n%s\n\n", e.Name, e.Expression, e.Name, e.Output)
+	}
+	return []byte(b.String())
+}
+
+// loadGolden reads and parses a single testdata/<topic>.golden file.
+func loadGolden(t *testing.T, topic string) []goldenEntry {
+	t.Helper()
+	path := filepath.Join("testdata", topic+".golden")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	entries, err := parseGolden(data)
+	if err != nil {
+		t.Fatalf("parsing %s: %v", path, err)
+	}
+	return entries
+}
+
+// goldenOutputFor renders the result of evaluating a golden case's
+// expression into the Output form used by testdata/*.golden files: "true"
+// if it evaluated to the CEL boolean true, or "ERROR: <message>" if
+// evaluation failed. Used by TestTypedToVal to regenerate a golden file's
+// expected results under -update.
+func goldenOutputFor(out ref.Val, err error) string {
+	if err != nil {
+		return "ERROR: " + err.Error()
+	}
+	if out == types.True {
+		return "true"
+	}
+	return fmt.Sprintf("unexpected: %v", out)
+}
+
+// writeGolden writes entries back to testdata/<topic>.golden, used by
+// TestTypedToVal under -update to regenerate expected results.
+func writeGolden(t *testing.T, topic string, entries []goldenEntry) {
+	t.Helper()
+	path := filepath.Join("testdata", topic+".golden")
+	if err := os.WriteFile(path, formatGolden(entries), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+}
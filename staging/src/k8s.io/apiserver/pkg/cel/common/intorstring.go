@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+// intOrStringCelType is the CEL type of values produced by newIntOrString:
+// unlike converting an intstr.IntOrString straight to a native int or
+// string, it gives the value an identity of its own, so type(x) reports it
+// and intValue()/strValue() (registered by IntOrString below) are callable
+// on it, while == against a plain int or string still works as before.
+var intOrStringCelType = types.NewOpaqueType("intOrString")
+
+// intOrStringVal adapts an intstr.IntOrString into a ref.Val that compares
+// equal to a plain CEL int or string exactly as the underlying Go value
+// would, but reports intOrStringCelType from Type() instead of int/string.
+// Conversion and comparison against the native representation (Value,
+// ConvertToNative, Equal against a non-intOrStringVal) are inherited
+// unchanged from the embedded int or string value.
+type intOrStringVal struct {
+	ref.Val
+	ios intstr.IntOrString
+}
+
+// newIntOrString returns v as a ref.Val: an intOrStringVal wrapping a
+// types.Int for intstr.Int, or a types.String for intstr.String.
+func newIntOrString(v intstr.IntOrString) ref.Val {
+	switch v.Type {
+	case intstr.Int:
+		return intOrStringVal{Val: types.Int(v.IntVal), ios: v}
+	case intstr.String:
+		return intOrStringVal{Val: types.String(v.StrVal), ios: v}
+	default:
+		return types.NewErr("unsupported intstr.Type: %v", v.Type)
+	}
+}
+
+func (v intOrStringVal) Type() ref.Type {
+	return intOrStringCelType
+}
+
+func (v intOrStringVal) ConvertToType(typeValue ref.Type) ref.Val {
+	switch typeValue {
+	case intOrStringCelType:
+		return v
+	case types.TypeType:
+		return intOrStringCelType
+	}
+	return v.Val.ConvertToType(typeValue)
+}
+
+func (v intOrStringVal) Equal(other ref.Val) ref.Val {
+	if o, ok := other.(intOrStringVal); ok {
+		return v.Val.Equal(o.Val)
+	}
+	return v.Val.Equal(other)
+}
+
+// Compare implements traits.Comparer by delegating to the embedded int or
+// string value's own Compare, so "self.port > 1024" works whether port holds
+// an int or a string, exactly as == already does for Equal. Unwrapping other
+// when it's also an intOrStringVal mirrors Equal's handling, so comparing two
+// IntOrString-typed fields against each other works the same way as
+// comparing one against a plain int or string literal.
+func (v intOrStringVal) Compare(other ref.Val) ref.Val {
+	cmp, ok := v.Val.(traits.Comparer)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(other)
+	}
+	if o, ok := other.(intOrStringVal); ok {
+		return cmp.Compare(o.Val)
+	}
+	return cmp.Compare(other)
+}
+
+// Add implements traits.Adder by delegating to the embedded int or string
+// value's own Add, so "self.port + 1" or "self.name + '-suffix'" works
+// according to whichever representation the value actually holds.
+func (v intOrStringVal) Add(other ref.Val) ref.Val {
+	add, ok := v.Val.(traits.Adder)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(other)
+	}
+	if o, ok := other.(intOrStringVal); ok {
+		return add.Add(o.Val)
+	}
+	return add.Add(other)
+}
+
+// IntOrString returns a cel.EnvOption that registers the "intOrString" type
+// name (so type(x) == intOrString can be written) and three member functions
+// for values produced by newIntOrString: intValue(), which returns the int
+// representation or errors if the value holds a string; strValue(), which
+// returns the string representation or errors if the value holds an int;
+// and isInt(), which reports which representation is held so a caller can
+// branch before calling intValue()/strValue() instead of relying on an
+// error.
+func IntOrString() celgo.EnvOption {
+	return celgo.Lib(intOrStringLib{})
+}
+
+type intOrStringLib struct{}
+
+func (intOrStringLib) CompileOptions() []celgo.EnvOption {
+	return []celgo.EnvOption{
+		celgo.Constant("intOrString", types.TypeType, intOrStringCelType),
+		celgo.Function("intValue",
+			celgo.MemberOverload("intorstring_int_value", []*celgo.Type{intOrStringCelType}, celgo.IntType,
+				celgo.UnaryBinding(func(arg ref.Val) ref.Val {
+					v := arg.(intOrStringVal)
+					if v.ios.Type != intstr.Int {
+						return types.NewErr("intValue() called on an IntOrString holding a string")
+					}
+					return types.Int(v.ios.IntVal)
+				}),
+			),
+		),
+		celgo.Function("strValue",
+			celgo.MemberOverload("intorstring_str_value", []*celgo.Type{intOrStringCelType}, celgo.StringType,
+				celgo.UnaryBinding(func(arg ref.Val) ref.Val {
+					v := arg.(intOrStringVal)
+					if v.ios.Type != intstr.String {
+						return types.NewErr("strValue() called on an IntOrString holding an int")
+					}
+					return types.String(v.ios.StrVal)
+				}),
+			),
+		),
+		celgo.Function("isInt",
+			celgo.MemberOverload("intorstring_is_int", []*celgo.Type{intOrStringCelType}, celgo.BoolType,
+				celgo.UnaryBinding(func(arg ref.Val) ref.Val {
+					v := arg.(intOrStringVal)
+					return types.Bool(v.ios.Type == intstr.Int)
+				}),
+			),
+		),
+	}
+}
+
+func (intOrStringLib) ProgramOptions() []celgo.ProgramOption {
+	return nil
+}
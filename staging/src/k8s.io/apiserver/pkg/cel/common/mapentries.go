@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+// MapEntries returns a cel.EnvOption that registers two member functions on
+// any CEL map, so a rule can work with keys and not just values: `.keys()`
+// returns the map's keys as a list (in the same key type Iterator yields
+// them, e.g. a types.Int list for an int-keyed map), and `.entries()`
+// returns a list of `{key: ..., value: ...}` maps so a single `.all()`/
+// `.exists()` macro can correlate a key with its value.
+func MapEntries() celgo.EnvOption {
+	return celgo.Lib(mapEntriesLib{})
+}
+
+type mapEntriesLib struct{}
+
+func (mapEntriesLib) CompileOptions() []celgo.EnvOption {
+	return []celgo.EnvOption{
+		celgo.Function("keys",
+			celgo.MemberOverload("map_keys", []*celgo.Type{celgo.MapType(celgo.DynType, celgo.DynType)}, celgo.ListType(celgo.DynType),
+				celgo.UnaryBinding(mapKeys),
+			),
+		),
+		celgo.Function("entries",
+			celgo.MemberOverload("map_entries", []*celgo.Type{celgo.MapType(celgo.DynType, celgo.DynType)}, celgo.ListType(celgo.DynType),
+				celgo.UnaryBinding(mapEntries),
+			),
+		),
+	}
+}
+
+func (mapEntriesLib) ProgramOptions() []celgo.ProgramOption {
+	return nil
+}
+
+func mapKeys(arg ref.Val) ref.Val {
+	m, ok := arg.(traits.Mapper)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(arg)
+	}
+	keys := make([]ref.Val, 0, int(m.Size().(types.Int)))
+	for it := m.Iterator(); it.HasNext() == types.True; {
+		keys = append(keys, it.Next())
+	}
+	return types.NewDynamicList(types.DefaultTypeAdapter, keys)
+}
+
+func mapEntries(arg ref.Val) ref.Val {
+	m, ok := arg.(traits.Mapper)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(arg)
+	}
+	entries := make([]ref.Val, 0, int(m.Size().(types.Int)))
+	for it := m.Iterator(); it.HasNext() == types.True; {
+		key := it.Next()
+		value := m.Get(key)
+		if types.IsError(value) {
+			return value
+		}
+		entries = append(entries, types.NewRefValMap(types.DefaultTypeAdapter, map[ref.Val]ref.Val{
+			types.String("key"):   key,
+			types.String("value"): value,
+		}))
+	}
+	return types.NewDynamicList(types.DefaultTypeAdapter, entries)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+// OptionalPath returns a cel.EnvOption registering an `optionalPath(root,
+// path)` function, alongside cel.OptionalTypes() so its result composes
+// with the stdlib's `orValue()`/`hasValue()`. path is a dot-separated
+// chain of struct field names, map keys, and (for a list) decimal
+// indices, e.g. `optionalPath(c, 'childPtr.info.s')` for `c.childPtr.info.s`.
+//
+// It walks the chain the same way plain field/index access would, but the
+// instant it hits a nil pointer, a missing map key, or an out-of-range
+// index it stops and returns optional.none() instead of erroring -- so a
+// single rule can safely descend through a struct mixing populated, nil,
+// and empty fields (ChildPtr vs. NilPtr/NilSlice/NilMap on the Complex
+// fixture) without a hand-rolled has() ladder for every level.
+//
+// A field that is present but holds its zero value (an explicitly-set
+// empty slice or a zero int) is NOT absent: only a path segment that
+// cannot be reached at all short-circuits to optional.none(). This
+// mirrors how Kubernetes treats an explicitly-set zero value as distinct
+// from a field that was never set, and falls directly out of reusing
+// structVal.lookupField's own omitempty handling below.
+func OptionalPath() celgo.EnvOption {
+	return celgo.Lib(optionalPathLib{})
+}
+
+type optionalPathLib struct{}
+
+func (optionalPathLib) CompileOptions() []celgo.EnvOption {
+	return []celgo.EnvOption{
+		celgo.OptionalTypes(),
+		celgo.Function("optionalPath",
+			celgo.Overload("optionalPath_dyn_string", []*celgo.Type{celgo.DynType, celgo.StringType}, celgo.OptionalType(celgo.DynType),
+				celgo.BinaryBinding(func(root, path ref.Val) ref.Val {
+					p, ok := path.(types.String)
+					if !ok {
+						return types.MaybeNoSuchOverloadErr(path)
+					}
+					return walkOptionalPath(root, string(p))
+				}),
+			),
+		),
+	}
+}
+
+func (optionalPathLib) ProgramOptions() []celgo.ProgramOption {
+	return nil
+}
+
+// walkOptionalPath resolves path against root segment by segment,
+// returning optional.none() the moment the chain becomes unreachable, or
+// optional.of(value) once every segment resolves.
+func walkOptionalPath(root ref.Val, path string) ref.Val {
+	cur := root
+	for _, seg := range strings.Split(path, ".") {
+		if cur == types.NullValue {
+			return types.OptionalNone
+		}
+		next, ok, errVal := stepPath(cur, seg)
+		if errVal != nil {
+			return errVal
+		}
+		if !ok {
+			return types.OptionalNone
+		}
+		cur = next
+	}
+	if cur == types.NullValue {
+		return types.OptionalNone
+	}
+	return types.OptionalOf(cur)
+}
+
+// stepPath resolves one path segment against cur: a struct field name, a
+// map key, or (for a list) a decimal index. errVal is non-nil only for a
+// malformed request (a segment that isn't a decimal index against a list,
+// or a value that can't be traversed at all); a segment that is well-formed but
+// simply unreachable (missing key, index past the end, omitted field)
+// reports ok=false with a nil errVal instead, so walkOptionalPath can
+// tell "the path doesn't exist here" from "the path is nonsensical."
+func stepPath(cur ref.Val, seg string) (next ref.Val, ok bool, errVal ref.Val) {
+	switch c := cur.(type) {
+	case *structVal:
+		v, found := c.lookupField(types.String(seg))
+		return v, found, nil
+	case traits.Mapper:
+		v, found := c.Find(types.String(seg))
+		return v, found, nil
+	case traits.Lister:
+		i, convErr := strconv.Atoi(seg)
+		if convErr != nil {
+			return nil, false, types.NewErr("optionalPath: %q is not a valid list index", seg)
+		}
+		size := int(c.Size().(types.Int))
+		if i < 0 || i >= size {
+			return nil, false, nil
+		}
+		return c.Get(types.Int(i)), true, nil
+	default:
+		return nil, false, types.NewErr("optionalPath: cannot traverse into %s at %q", cur.Type().TypeName(), seg)
+	}
+}
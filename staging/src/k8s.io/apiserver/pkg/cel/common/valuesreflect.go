@@ -23,17 +23,197 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apiserver/pkg/cel"
+	"math"
 	"reflect"
 	"sigs.k8s.io/structured-merge-diff/v4/value"
+	"sync"
+	"time"
 
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
 	"github.com/google/cel-go/common/types/traits"
 )
 
+// ListType mirrors the Kubernetes +listType=atomic|set|map marker, and
+// controls how a slice-typed field's ref.Val compares under CEL ==.
+type ListType int
+
+const (
+	// ListTypeAtomic is the default: slices compare strictly by index and
+	// order, matching Go slice equality semantics.
+	ListTypeAtomic ListType = iota
+	// ListTypeSet compares slices as unordered sets: equal iff they contain
+	// the same elements, regardless of order.
+	ListTypeSet
+	// ListTypeMap compares slices as unordered sets of elements identified
+	// by a key: equal iff they contain the same set of keyed elements,
+	// regardless of order. Element equality (not just key equality) is
+	// still required, matching Kubernetes' +listType=map semantics.
+	ListTypeMap
+)
+
+// listTypeKey identifies a single slice-typed struct field: the struct's
+// reflect.Type and the field's JSON name.
+type listTypeKey struct {
+	structType reflect.Type
+	jsonName   string
+}
+
+// listTypeEntry is the ListType and (for ListTypeMap) key field names
+// registered for one listTypeKey.
+type listTypeEntry struct {
+	listType ListType
+	keys     []string
+}
+
+// floatTolerance is the margin used by tolerantDouble.Equal, modeled on
+// cmpopts.EquateApprox: two floats are equal if their absolute difference is
+// at most (absolute + fraction * the larger of their magnitudes).
+type floatTolerance struct {
+	fraction, absolute float64
+}
+
+// TypedToValOptions configures the Go-type-to-CEL conversion performed by a
+// converter returned from NewConverter.
+type TypedToValOptions struct {
+	transformers   map[reflect.Type]func(interface{}) ref.Val
+	listTypes      map[listTypeKey]listTypeEntry
+	floatTolerance *floatTolerance
+	timeTolerance  *time.Duration
+	limits         *EvalLimits
+}
+
+// EvalLimits bounds the amount of work the reflection-backed CEL adapters in
+// this file will perform against a single Go value tree, so that evaluating
+// a validation rule against a pathologically large or deeply nested object
+// (an oversized or maliciously crafted custom resource, say) can't force a
+// validator to allocate unbounded memory or spend unbounded CPU during
+// admission.
+type EvalLimits struct {
+	// MaxAddResultSize bounds the length of the slice sliceVal.Add may
+	// build via reflect.Append. Add returns a types.NewErr once the result
+	// would exceed it, instead of continuing to grow it. Zero means
+	// unbounded.
+	MaxAddResultSize int
+	// MaxIterElements bounds how many elements a single Iterator may yield
+	// over its lifetime. Next returns a types.NewErr once the limit is
+	// reached, instead of converting and returning a further element, and
+	// the Iterator then reports no further elements. Zero means unbounded.
+	MaxIterElements int
+	// MaxDepth bounds how many levels of nested struct/slice/map a value
+	// may recurse through -- a field holding a slice of structs each
+	// holding a map counts as three levels, regardless of how many
+	// elements each container has. Recursing past the limit yields a
+	// types.NewErr in place of the over-deep container. Zero means
+	// unbounded.
+	MaxDepth int
+}
+
+// DefaultEvalLimits returns the EvalLimits this package recommends for
+// evaluating CEL validation rules against admitted Kubernetes API objects:
+// generous enough for any realistic object graph, but bounded well below
+// what a single oversized or deeply nested object could use to exhaust
+// admission-time memory or CPU. These are standalone defaults chosen for
+// this adapter, not a reflection of apiserver's separate CEL cost-budget
+// accounting, which lives outside this package.
+func DefaultEvalLimits() EvalLimits {
+	return EvalLimits{
+		MaxAddResultSize: 100_000,
+		MaxIterElements:  1_000_000,
+		MaxDepth:         64,
+	}
+}
+
+// WithEvalLimits installs limits, enforced by every sliceVal/mapVal/
+// structVal produced by the returned converter (and by any container
+// reachable through it, via struct fields, slice elements, or map values).
+func WithEvalLimits(limits EvalLimits) TypedToValOption {
+	return func(o *TypedToValOptions) {
+		o.limits = &limits
+	}
+}
+
+// TypedToValOption sets one option on a TypedToValOptions.
+type TypedToValOption func(*TypedToValOptions)
+
+// WithTransformer registers fn as the conversion for any value whose
+// concrete (post-pointer-dereference) type is t, analogous to a
+// cmp.Transformer in go-cmp. A registered transformer short-circuits the
+// built-in special cases and reflect-based conversion, including when the
+// type is encountered nested inside a struct, slice, or map.
+func WithTransformer(t reflect.Type, fn func(interface{}) ref.Val) TypedToValOption {
+	return func(o *TypedToValOptions) {
+		if o.transformers == nil {
+			o.transformers = make(map[reflect.Type]func(interface{}) ref.Val)
+		}
+		o.transformers[t] = fn
+	}
+}
+
+// WithListType declares that the slice-typed field named jsonName (its JSON
+// name, as looked up via its struct's field cache) of structType carries
+// Kubernetes +listType=set or +listType=map semantics, so that CEL ==
+// compares it as unordered rather than by strict index/order. keys names
+// the element fields (by JSON name) that form the map key; it is required
+// for ListTypeMap and ignored for ListTypeSet.
+//
+// This only affects CEL ==; "in" (traits.Container) is already
+// order-independent, and CEL list subtraction ("-", traits.Subtractor) is
+// not implemented by sliceVal regardless of ListType.
+func WithListType(structType reflect.Type, jsonName string, lt ListType, keys ...string) TypedToValOption {
+	return func(o *TypedToValOptions) {
+		if o.listTypes == nil {
+			o.listTypes = make(map[listTypeKey]listTypeEntry)
+		}
+		o.listTypes[listTypeKey{structType, jsonName}] = listTypeEntry{listType: lt, keys: keys}
+	}
+}
+
+// WithFloatTolerance makes double-typed values (Go float32/float64) compare
+// equal under CEL == if their absolute difference is at most (absolute +
+// fraction * the larger of their magnitudes), analogous to
+// cmpopts.EquateApprox. This makes rules like "self.f == oldSelf.f" robust
+// to float round-tripping through JSON.
+func WithFloatTolerance(fraction, absolute float64) TypedToValOption {
+	return func(o *TypedToValOptions) {
+		o.floatTolerance = &floatTolerance{fraction: fraction, absolute: absolute}
+	}
+}
+
+// WithTimeTolerance makes timestamp-typed values (metav1.Time) compare equal
+// under CEL == if they are within d of each other, analogous to
+// cmpopts.EquateApproxTime. This makes rules on metav1.Time robust to
+// sub-second precision loss from re-serialization.
+func WithTimeTolerance(d time.Duration) TypedToValOption {
+	return func(o *TypedToValOptions) {
+		o.timeTolerance = &d
+	}
+}
+
+// NewConverter returns a function equivalent to TypedToVal, extended with
+// any transformers registered via opts. The returned converter is used
+// consistently for nested fields, slice elements, and map values, so a
+// registered transformer applies everywhere the type appears.
+func NewConverter(opts ...TypedToValOption) func(interface{}) ref.Val {
+	o := &TypedToValOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if len(o.transformers) == 0 && len(o.listTypes) == 0 && o.floatTolerance == nil && o.timeTolerance == nil && o.limits == nil {
+		return TypedToVal
+	}
+	return func(val interface{}) ref.Val {
+		return typedToVal(val, o)
+	}
+}
+
 // TypedToVal wraps "typed" Go value as CEL ref.Val types using reflection.
 // "typed" values must be values declared by Kubernetes API types.go definitions.
 func TypedToVal(val interface{}) ref.Val {
+	return typedToVal(val, nil)
+}
+
+func typedToVal(val interface{}, opts *TypedToValOptions) ref.Val {
 	if val == nil {
 		return types.NullValue
 	}
@@ -49,6 +229,16 @@ func TypedToVal(val interface{}) ref.Val {
 	}
 	val = v.Interface()
 
+	if opts != nil {
+		if fn, ok := opts.transformers[v.Type()]; ok {
+			return fn(val)
+		}
+	}
+
+	if et, ok := lookupEnumType(v.Type()); ok {
+		return newEnumVal(et, v.String())
+	}
+
 	switch typedVal := val.(type) {
 	case bool:
 		return types.Bool(typedVal)
@@ -59,9 +249,9 @@ func TypedToVal(val interface{}) ref.Val {
 	case int64:
 		return types.Int(typedVal)
 	case float32:
-		return types.Double(typedVal)
+		return newDouble(float64(typedVal), opts)
 	case float64:
-		return types.Double(typedVal)
+		return newDouble(typedVal, opts)
 	case string:
 		return types.String(typedVal)
 	case []byte:
@@ -70,16 +260,11 @@ func TypedToVal(val interface{}) ref.Val {
 		}
 		return types.Bytes(typedVal)
 	case metav1.Time:
-		return types.Timestamp{Time: typedVal.Time}
+		return newTimestamp(typedVal.Time, opts)
 	case metav1.Duration:
 		return types.Duration{Duration: typedVal.Duration}
 	case intstr.IntOrString:
-		switch typedVal.Type {
-		case intstr.Int:
-			return types.Int(typedVal.IntVal)
-		case intstr.String:
-			return types.String(typedVal.StrVal)
-		}
+		return newIntOrString(typedVal)
 	case resource.Quantity:
 		return cel.Quantity{Quantity: &typedVal}
 	default:
@@ -88,28 +273,334 @@ func TypedToVal(val interface{}) ref.Val {
 
 	switch v.Kind() {
 	case reflect.Slice:
-		return &sliceVal{value: v}
+		return &sliceVal{value: v, opts: opts}
 	case reflect.Map:
-		return &mapVal{value: v}
+		return &mapVal{value: v, opts: opts}
 	case reflect.Struct:
-		return &structVal{value: v}
+		return &structVal{value: v, opts: opts}
 	// Match type aliases to primitives by kind
 	case reflect.Bool:
 		return types.Bool(v.Bool())
 	case reflect.String:
 		return types.String(v.String())
-	case reflect.Int, reflect.Int32, reflect.Int64:
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return types.Int(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return types.Uint(v.Uint())
 	case reflect.Float32, reflect.Float64:
-		return types.Double(v.Float())
+		return newDouble(v.Float(), opts)
 	default:
 		return types.NewErr("unsupported Go type for CEL: %v", v.Type())
 	}
 }
 
+// newDouble returns f as a types.Double, or a tolerantDouble if opts
+// configures a float tolerance.
+func newDouble(f float64, opts *TypedToValOptions) ref.Val {
+	if opts != nil && opts.floatTolerance != nil {
+		return tolerantDouble{Double: types.Double(f), tol: *opts.floatTolerance}
+	}
+	return types.Double(f)
+}
+
+// tolerantDouble is a types.Double whose Equal uses floatTolerance instead
+// of strict equality; all other behavior (comparison, arithmetic, type
+// conversion) is inherited unchanged from the embedded types.Double.
+type tolerantDouble struct {
+	types.Double
+	tol floatTolerance
+}
+
+func (d tolerantDouble) Equal(other ref.Val) ref.Val {
+	var ov float64
+	switch o := other.(type) {
+	case tolerantDouble:
+		ov = float64(o.Double)
+	case types.Double:
+		ov = float64(o)
+	default:
+		return d.Double.Equal(other)
+	}
+	nv := float64(d.Double)
+	diff := math.Abs(nv - ov)
+	margin := d.tol.absolute + d.tol.fraction*math.Max(math.Abs(nv), math.Abs(ov))
+	return types.Bool(diff <= margin)
+}
+
+// newTimestamp returns t as a types.Timestamp, or a tolerantTimestamp if
+// opts configures a time tolerance.
+func newTimestamp(t time.Time, opts *TypedToValOptions) ref.Val {
+	if opts != nil && opts.timeTolerance != nil {
+		return tolerantTimestamp{Timestamp: types.Timestamp{Time: t}, tol: *opts.timeTolerance}
+	}
+	return types.Timestamp{Time: t}
+}
+
+// tolerantTimestamp is a types.Timestamp whose Equal accepts differences up
+// to timeTolerance instead of requiring exact equality; all other behavior
+// is inherited unchanged from the embedded types.Timestamp.
+type tolerantTimestamp struct {
+	types.Timestamp
+	tol time.Duration
+}
+
+func (t tolerantTimestamp) Equal(other ref.Val) ref.Val {
+	var ot time.Time
+	switch o := other.(type) {
+	case tolerantTimestamp:
+		ot = o.Time
+	case types.Timestamp:
+		ot = o.Time
+	default:
+		return t.Timestamp.Equal(other)
+	}
+	diff := t.Time.Sub(ot)
+	if diff < 0 {
+		diff = -diff
+	}
+	return types.Bool(diff <= t.tol)
+}
+
+// structCache memoizes, per struct reflect.Type, the CEL field name, kind
+// classification, and conversion for every field, so that a structVal need
+// not re-walk reflect fields, re-parse JSON tags, or re-classify a field's
+// kind on every Get/IsSet call; it pays that cost once per type instead.
+// Cache entries are never invalidated: Go types are immutable at runtime,
+// so a type's field layout cannot change once this process starts.
+var structCache sync.Map // map[reflect.Type]*cachedStruct
+
+// elemConverterCache memoizes, per element reflect.Type found as a slice or
+// map's Type().Elem(), the same classification/fast-path dispatch
+// buildCachedStruct computes for a struct field of that type -- so
+// sliceVal/mapVal need not re-run typedToVal's full type switch for every
+// element of every value sharing that element type. Like structCache, this
+// is never invalidated: Go types never change shape at runtime.
+var elemConverterCache sync.Map // map[reflect.Type]func(reflect.Value, *TypedToValOptions) ref.Val
+
+// elemConverterOf returns the conversion function for elements of type t,
+// building and memoizing it on first use.
+func elemConverterOf(t reflect.Type) func(reflect.Value, *TypedToValOptions) ref.Val {
+	if fn, ok := elemConverterCache.Load(t); ok {
+		return fn.(func(reflect.Value, *TypedToValOptions) ref.Val)
+	}
+	fn, _ := elemConverterCache.LoadOrStore(t, buildElemConverter(t))
+	return fn.(func(reflect.Value, *TypedToValOptions) ref.Val)
+}
+
+// buildElemConverter classifies t the same way buildCachedStruct classifies
+// a struct field's static type, then hands off to fieldConverter for the
+// actual fast-path dispatch.
+func buildElemConverter(t reflect.Type) func(reflect.Value, *TypedToValOptions) ref.Val {
+	cf := cachedField{
+		kind:          t.Kind(),
+		isTime:        t == timeType,
+		isDuration:    t == durationType,
+		isQuantity:    t == quantityType,
+		isIntOrString: t == intOrStringType,
+	}
+	if et, ok := lookupEnumType(t); ok {
+		cf.enum = et
+	}
+	if cf.kind == reflect.Slice {
+		cf.elemType = t.Elem()
+	}
+	return fieldConverter(cf)
+}
+
+var (
+	timeType        = reflect.TypeOf(metav1.Time{})
+	durationType    = reflect.TypeOf(metav1.Duration{})
+	quantityType    = reflect.TypeOf(resource.Quantity{})
+	intOrStringType = reflect.TypeOf(intstr.IntOrString{})
+)
+
+// cachedField is the metadata cachedStructOf resolves once for a single
+// field of a struct type and reuses for every instance of that type.
+type cachedField struct {
+	entry *value.FieldCacheEntry
+
+	kind     reflect.Kind
+	elemType reflect.Type // valid when kind == reflect.Slice
+
+	isTime        bool
+	isDuration    bool
+	isQuantity    bool
+	isIntOrString bool
+	enum          *enumType // non-nil if the field's type was registered via RegisterEnum
+
+	// convert produces this field's ref.Val from its reflect.Value, bound
+	// to the field's static kind so Get need not re-discover it via a type
+	// switch on every call. opts is still threaded through since
+	// transformers/list types/tolerances are configured per TypedToVal
+	// call, not per field.
+	convert func(fv reflect.Value, opts *TypedToValOptions) ref.Val
+}
+
+// cachedStruct is the cachedField set for one struct reflect.Type, plus a
+// name index for O(1) lookup from a CEL field access.
+type cachedStruct struct {
+	fields []cachedField
+	byName map[string]int
+}
+
+// cachedStructOf returns the cachedStruct for t, building and memoizing it
+// on first use.
+func cachedStructOf(t reflect.Type) *cachedStruct {
+	if cs, ok := structCache.Load(t); ok {
+		return cs.(*cachedStruct)
+	}
+	cs, _ := structCache.LoadOrStore(t, buildCachedStruct(t))
+	return cs.(*cachedStruct)
+}
+
+// buildCachedStruct resolves every field of t once, using a zero value of t
+// to discover each field's static Go type (GetFrom works on any value of
+// the right type, zero or not; only CanOmit's result differs per-instance).
+func buildCachedStruct(t reflect.Type) *cachedStruct {
+	zero := reflect.New(t).Elem()
+	fieldsByName := value.TypeReflectEntryOf(t).Fields()
+	cs := &cachedStruct{
+		fields: make([]cachedField, 0, len(fieldsByName)),
+		byName: make(map[string]int, len(fieldsByName)),
+	}
+	for name, entry := range fieldsByName {
+		ft := entry.GetFrom(zero).Type()
+		cf := cachedField{
+			entry:         entry,
+			kind:          ft.Kind(),
+			isTime:        ft == timeType,
+			isDuration:    ft == durationType,
+			isQuantity:    ft == quantityType,
+			isIntOrString: ft == intOrStringType,
+		}
+		if et, ok := lookupEnumType(ft); ok {
+			cf.enum = et
+		}
+		if cf.kind == reflect.Slice {
+			cf.elemType = ft.Elem()
+		}
+		cf.convert = fieldConverter(cf)
+		cs.byName[name] = len(cs.fields)
+		cs.fields = append(cs.fields, cf)
+	}
+	return cs
+}
+
+// fieldConverter returns the conversion function for a field with the given
+// static classification. The common scalar kinds TypedToVal recognizes are
+// special-cased so Get need not re-run typedToVal's type switch; anything
+// else (struct, slice, map, pointer, ...) still recurses through
+// typedToVal, which handles pointer dereferencing and options uniformly.
+// Every path still checks opts.transformers first, exactly as typedToVal
+// does, so a transformer registered for this field's type always wins.
+func fieldConverter(cf cachedField) func(reflect.Value, *TypedToValOptions) ref.Val {
+	var fast func(fv reflect.Value, opts *TypedToValOptions) ref.Val
+	switch {
+	case cf.isTime:
+		fast = func(fv reflect.Value, opts *TypedToValOptions) ref.Val {
+			return newTimestamp(fv.Interface().(metav1.Time).Time, opts)
+		}
+	case cf.isDuration:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val {
+			return types.Duration{Duration: fv.Interface().(metav1.Duration).Duration}
+		}
+	case cf.isQuantity:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val {
+			q := fv.Interface().(resource.Quantity)
+			return cel.Quantity{Quantity: &q}
+		}
+	case cf.isIntOrString:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val {
+			return newIntOrString(fv.Interface().(intstr.IntOrString))
+		}
+	case cf.enum != nil:
+		et := cf.enum
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val {
+			return newEnumVal(et, fv.String())
+		}
+	case cf.kind == reflect.Bool:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val { return types.Bool(fv.Bool()) }
+	case cf.kind == reflect.String:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val { return types.String(fv.String()) }
+	case cf.kind == reflect.Int, cf.kind == reflect.Int8, cf.kind == reflect.Int16, cf.kind == reflect.Int32, cf.kind == reflect.Int64:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val { return types.Int(fv.Int()) }
+	case cf.kind == reflect.Uint, cf.kind == reflect.Uint8, cf.kind == reflect.Uint16, cf.kind == reflect.Uint32, cf.kind == reflect.Uint64:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val { return types.Uint(fv.Uint()) }
+	case cf.kind == reflect.Float32, cf.kind == reflect.Float64:
+		fast = func(fv reflect.Value, opts *TypedToValOptions) ref.Val { return newDouble(fv.Float(), opts) }
+	case cf.kind == reflect.Slice && cf.elemType.Kind() == reflect.Uint8:
+		fast = func(fv reflect.Value, _ *TypedToValOptions) ref.Val {
+			b := fv.Interface().([]byte)
+			if b == nil {
+				return types.NullValue
+			}
+			return types.Bytes(b)
+		}
+	default:
+		fast = func(fv reflect.Value, opts *TypedToValOptions) ref.Val {
+			return typedToVal(fv.Interface(), opts)
+		}
+	}
+	return func(fv reflect.Value, opts *TypedToValOptions) ref.Val {
+		if opts != nil {
+			if fn, ok := opts.transformers[fv.Type()]; ok {
+				return fn(fv.Interface())
+			}
+		}
+		return fast(fv, opts)
+	}
+}
+
 // structVal wraps a struct as a CEL ref.Val and provides lazy access to fields via reflection.
+//
+// structVal and sliceVal are deliberately not pooled: a returned ref.Val's
+// lifetime isn't bounded to the Get/Iterator call that produced it -- CEL
+// may retain it across comparisons, store it in a result, or hand it to a
+// macro that evaluates lazily -- and reusing one across calls without a
+// clear retention contract would risk a value changing out from under a
+// caller that's still holding it. The caching in this file instead targets
+// the type-classification work (field layout, element kind), which is safe
+// to memoize because it depends only on the Go type, not on any particular
+// value.
 type structVal struct {
 	value reflect.Value // Kind is required to be: reflect.Struct
+	opts  *TypedToValOptions
+	depth int // nesting depth from the root value this was reached from; see EvalLimits.MaxDepth
+}
+
+// withDepth assigns parentDepth+1 to v's depth field if v is one of the
+// reflection-backed container ref.Vals this file produces (*structVal,
+// *sliceVal, *mapVal) -- the only ones that can recurse further -- and
+// enforces opts.limits.MaxDepth, replacing v with a types.NewErr if the new
+// depth would exceed it. Scalar ref.Vals pass through unchanged. Depth is
+// assigned here, at each point a container recurses into a nested
+// container, rather than threaded as a parameter through every conversion
+// function, since most of those (fieldConverter's fast paths, elemConverterOf)
+// are shared across both depth-tracked and depth-untracked (opts == nil)
+// callers.
+func withDepth(v ref.Val, opts *TypedToValOptions, parentDepth int) ref.Val {
+	if opts == nil || opts.limits == nil || opts.limits.MaxDepth <= 0 {
+		return v
+	}
+	d := parentDepth + 1
+	switch c := v.(type) {
+	case *structVal:
+		if d > opts.limits.MaxDepth {
+			return types.NewErr("max nesting depth of %d exceeded", opts.limits.MaxDepth)
+		}
+		c.depth = d
+	case *sliceVal:
+		if d > opts.limits.MaxDepth {
+			return types.NewErr("max nesting depth of %d exceeded", opts.limits.MaxDepth)
+		}
+		c.depth = d
+	case *mapVal:
+		if d > opts.limits.MaxDepth {
+			return types.NewErr("max nesting depth of %d exceeded", opts.limits.MaxDepth)
+		}
+		c.depth = d
+	}
+	return v
 }
 
 func (s *structVal) ConvertToNative(typeDesc reflect.Type) (interface{}, error) {
@@ -178,20 +669,37 @@ func (s *structVal) lookupField(key ref.Val) (ref.Val, bool) {
 	}
 	fieldName := keyStr.Value().(string)
 
-	cacheEntry := value.TypeReflectEntryOf(s.value.Type())
-	fieldCache, ok := cacheEntry.Fields()[fieldName]
+	cs := cachedStructOf(s.value.Type())
+	idx, ok := cs.byName[fieldName]
 	if !ok {
 		return nil, false
 	}
+	cf := &cs.fields[idx]
 
-	if e := fieldCache.GetFrom(s.value); !fieldCache.CanOmit(e) {
-		return TypedToVal(e.Interface()), true
+	e := cf.entry.GetFrom(s.value)
+	if cf.entry.CanOmit(e) {
+		return nil, false
 	}
-	return nil, false
+	if s.opts != nil && cf.kind == reflect.Slice {
+		if entry, ok := s.opts.listTypes[listTypeKey{s.value.Type(), fieldName}]; ok {
+			v := withDepth(&sliceVal{value: e, opts: s.opts, listType: entry.listType, listKeys: entry.keys}, s.opts, s.depth)
+			return v, true
+		}
+	}
+	return withDepth(cf.convert(e, s.opts), s.opts, s.depth), true
 }
 
 type sliceVal struct {
 	value reflect.Value // Kind is required to be: reflect.Slice
+	opts  *TypedToValOptions
+
+	// listType and listKeys carry +listType=set|map semantics down from the
+	// struct field this sliceVal was built for (see WithListType); zero
+	// value is ListTypeAtomic, the default strict index/order comparison.
+	listType ListType
+	listKeys []string
+
+	depth int // nesting depth from the root value this was reached from; see EvalLimits.MaxDepth
 }
 
 func (t *sliceVal) ConvertToNative(typeDesc reflect.Type) (interface{}, error) {
@@ -222,6 +730,9 @@ func (t *sliceVal) Equal(other ref.Val) ref.Val {
 	if sz != oList.Size() {
 		return types.False
 	}
+	if t.listType == ListTypeSet || t.listType == ListTypeMap {
+		return t.equalUnordered(oList)
+	}
 	for i := types.Int(0); i < sz; i++ {
 		eq := t.Get(i).Equal(oList.Get(i))
 		if eq != types.True {
@@ -231,6 +742,40 @@ func (t *sliceVal) Equal(other ref.Val) ref.Val {
 	return types.True
 }
 
+// equalUnordered implements +listType=set and +listType=map equality: two
+// lists of the same size are equal iff every element of t has a distinct
+// equal counterpart in other, independent of position. This also correctly
+// implements keyed +listType=map equality (same set of key tuples, each
+// with an equal element), since full element equality necessarily implies
+// key equality, and Kubernetes list-map keys are unique within a list.
+func (t *sliceVal) equalUnordered(other traits.Lister) ref.Val {
+	n := t.value.Len()
+	matched := make([]bool, n)
+	for i := 0; i < n; i++ {
+		elem := t.Get(types.Int(i))
+		found := false
+		for j := 0; j < n; j++ {
+			if matched[j] {
+				continue
+			}
+			eq := elem.Equal(other.Get(types.Int(j)))
+			b, ok := eq.(types.Bool)
+			if !ok {
+				return eq // propagate error/unknown
+			}
+			if bool(b) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return types.False
+		}
+	}
+	return types.True
+}
+
 func (t *sliceVal) Type() ref.Type {
 	return types.ListType
 }
@@ -244,13 +789,20 @@ func (t *sliceVal) Add(other ref.Val) ref.Val {
 	if !ok {
 		return types.MaybeNoSuchOverloadErr(other)
 	}
+	var maxSize int
+	if t.opts != nil && t.opts.limits != nil {
+		maxSize = t.opts.limits.MaxAddResultSize
+	}
 	resultValue := t.value
 	for it := oList.Iterator(); it.HasNext() == types.True; {
+		if maxSize > 0 && resultValue.Len() >= maxSize {
+			return types.NewErr("Add result exceeds maximum list size of %d", maxSize)
+		}
 		next := it.Next().Value()
 		resultValue = reflect.Append(resultValue, reflect.ValueOf(next))
 	}
 
-	return &sliceVal{value: resultValue}
+	return &sliceVal{value: resultValue, opts: t.opts, listType: t.listType, listKeys: t.listKeys, depth: t.depth}
 }
 
 func (t *sliceVal) Contains(val ref.Val) ref.Val {
@@ -259,8 +811,9 @@ func (t *sliceVal) Contains(val ref.Val) ref.Val {
 	}
 	var err ref.Val
 	sz := t.value.Len()
+	convert := elemConverterOf(t.value.Type().Elem())
 	for i := 0; i < sz; i++ {
-		elem := TypedToVal(t.value.Index(i).Interface())
+		elem := convert(t.value.Index(i), t.opts)
 		cmp := elem.Equal(val)
 		b, ok := cmp.(types.Bool)
 		if !ok && err == nil {
@@ -285,16 +838,16 @@ func (t *sliceVal) Get(idx ref.Val) ref.Val {
 	if i < 0 || i >= t.value.Len() {
 		return types.NewErr("index out of bounds: %v", idx)
 	}
-	return TypedToVal(t.value.Index(i).Interface())
+	elem := elemConverterOf(t.value.Type().Elem())(t.value.Index(i), t.opts)
+	return withDepth(elem, t.opts, t.depth)
 }
 
+// Iterator returns an iterator that converts each element lazily, on Next(),
+// rather than materializing every element's ref.Val up front -- a `for x in
+// list` that breaks out early (or an "exists"/"all" macro that short-circuits)
+// never pays to convert elements it never visits.
 func (t *sliceVal) Iterator() traits.Iterator {
-	elements := make([]ref.Val, t.value.Len())
-	sz := t.value.Len()
-	for i := 0; i < sz; i++ {
-		elements[i] = TypedToVal(t.value.Index(i).Interface())
-	}
-	return &sliceIter{sliceVal: t, elements: elements}
+	return &sliceIter{sliceVal: t, convert: elemConverterOf(t.value.Type().Elem())}
 }
 
 func (t *sliceVal) Size() ref.Val {
@@ -303,25 +856,31 @@ func (t *sliceVal) Size() ref.Val {
 
 type sliceIter struct {
 	*sliceVal
-	elements []ref.Val
-	idx      int
+	convert func(reflect.Value, *TypedToValOptions) ref.Val
+	idx     int
 }
 
 func (it *sliceIter) HasNext() ref.Val {
-	return types.Bool(it.idx < len(it.elements))
+	return types.Bool(it.idx < it.value.Len())
 }
 
 func (it *sliceIter) Next() ref.Val {
-	if it.idx >= len(it.elements) {
+	if it.idx >= it.value.Len() {
 		return types.NewErr("iterator exhausted")
 	}
-	elem := it.elements[it.idx]
+	if it.opts != nil && it.opts.limits != nil && it.opts.limits.MaxIterElements > 0 && it.idx >= it.opts.limits.MaxIterElements {
+		it.idx = it.value.Len()
+		return types.NewErr("iteration limit of %d elements exceeded", it.opts.limits.MaxIterElements)
+	}
+	elem := withDepth(it.convert(it.value.Index(it.idx), it.opts), it.opts, it.depth)
 	it.idx++
 	return elem
 }
 
 type mapVal struct {
 	value reflect.Value // Kind is required to be: reflect.Map
+	opts  *TypedToValOptions
+	depth int // nesting depth from the root value this was reached from; see EvalLimits.MaxDepth
 }
 
 func (t *mapVal) ConvertToNative(typeDesc reflect.Type) (interface{}, error) {
@@ -351,14 +910,14 @@ func (t *mapVal) Equal(other ref.Val) ref.Val {
 	if types.Int(t.value.Len()) != oMap.Size() {
 		return types.False
 	}
+	convert := elemConverterOf(t.value.Type().Elem())
 	for it := t.value.MapRange(); it.Next(); {
-		key := it.Key()
-		value := it.Value()
-		ov, found := oMap.Find(types.String(key.String()))
+		key := typedToVal(it.Key().Interface(), t.opts)
+		ov, found := oMap.Find(key)
 		if !found {
 			return types.False
 		}
-		v := TypedToVal(value.Interface())
+		v := convert(it.Value(), t.opts)
 		vEq := v.Equal(ov)
 		if vEq != types.True {
 			return vEq // either false or error
@@ -396,38 +955,98 @@ func (t *mapVal) Size() ref.Val {
 	return types.Int(t.value.Len())
 }
 
+// Find looks up key in the map. key may be any ref.Val TypedToVal would
+// produce for the map's native key type (types.String for a string-keyed
+// map, types.Int for an int-keyed map, types.Bool for a bool-keyed map,
+// ...), not only types.String: Kubernetes types keyed by, e.g., an int32
+// resource port are just as valid a map[K]V as a string-keyed one, and the
+// CEL adapter must iterate and index them the same way.
 func (t *mapVal) Find(key ref.Val) (ref.Val, bool) {
-	keyStr, ok := key.(types.String)
+	k, ok := nativeMapKey(t.value.Type().Key(), key)
 	if !ok {
 		return types.MaybeNoSuchOverloadErr(key), true
 	}
-	k := keyStr.Value().(string)
-	if v := t.value.MapIndex(reflect.ValueOf(k)); v.IsValid() {
-		return TypedToVal(v.Interface()), true
+	if v := t.value.MapIndex(k); v.IsValid() {
+		elem := elemConverterOf(t.value.Type().Elem())(v, t.opts)
+		return withDepth(elem, t.opts, t.depth), true
 	}
 	return nil, false
 }
 
-func (t *mapVal) Iterator() traits.Iterator {
-	keys := make([]ref.Val, t.value.Len())
-	for i, k := range t.value.MapKeys() {
-		keys[i] = types.String(k.String())
+// nativeMapKey converts a CEL key value into the reflect.Value MapIndex
+// expects for a map whose key type is keyType. ok is false if key's CEL
+// type doesn't match keyType's kind; an unsigned keyType also accepts a
+// non-negative types.Int, the type of a CEL integer literal. reflect.Convert handles named string
+// and integer key types (e.g. a Kubernetes ResourceName or a typedef over
+// int32) the same way it handles the unaliased kind, so keyType need not be
+// exactly string/int/bool -- only share their underlying Kind.
+func nativeMapKey(keyType reflect.Type, key ref.Val) (reflect.Value, bool) {
+	switch keyType.Kind() {
+	case reflect.String:
+		k, ok := key.(types.String)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(string(k)).Convert(keyType), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		k, ok := key.(types.Int)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(int64(k)).Convert(keyType), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch k := key.(type) {
+		case types.Uint:
+			return reflect.ValueOf(uint64(k)).Convert(keyType), true
+		case types.Int:
+			if k < 0 {
+				return reflect.Value{}, false
+			}
+			return reflect.ValueOf(uint64(k)).Convert(keyType), true
+		}
+		return reflect.Value{}, false
+	case reflect.Bool:
+		k, ok := key.(types.Bool)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(bool(k)).Convert(keyType), true
+	default:
+		return reflect.Value{}, false
 	}
-	return &mapIter{mapVal: t, keys: keys}
+}
+
+// Iterator yields this map's keys, each converted by the same typedToVal
+// used for its values, so a CEL `for k in m` or `m.all(k, ...)` binds k to
+// a types.String, types.Int, types.Uint, or types.Bool matching the map's
+// native key kind -- never a stringified placeholder for non-string keys.
+// Keys are walked lazily via reflect.Value.MapRange rather than collected up
+// front with MapKeys(), so a huge map doesn't pay an O(n) key-materialization
+// allocation before EvalLimits.MaxIterElements ever gets a chance to bound
+// the iteration.
+func (t *mapVal) Iterator() traits.Iterator {
+	it := t.value.MapRange()
+	return &mapIter{mapVal: t, it: it, more: it.Next()}
 }
 
 type mapIter struct {
 	*mapVal
-	keys []ref.Val
+	it   *reflect.MapIter
+	more bool
 	idx  int
 }
 
 func (it *mapIter) HasNext() ref.Val {
-	return types.Bool(it.idx < len(it.keys))
+	return types.Bool(it.more)
 }
 
 func (it *mapIter) Next() ref.Val {
-	key := it.keys[it.idx]
+	if it.opts != nil && it.opts.limits != nil && it.opts.limits.MaxIterElements > 0 && it.idx >= it.opts.limits.MaxIterElements {
+		it.more = false
+		return types.NewErr("iteration limit of %d elements exceeded", it.opts.limits.MaxIterElements)
+	}
+	key := typedToVal(it.it.Key().Interface(), it.opts)
 	it.idx++
+	it.more = it.it.Next()
 	return key
 }
@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// benchComplex builds a Complex value with every field populated, used by
+// the field-access benchmarks below to approximate a struct with many
+// validated fields.
+func benchComplex() Complex {
+	return Complex{
+		TypeMeta:    metav1.TypeMeta{Kind: "Complex", APIVersion: "v1"},
+		ObjectMeta:  metav1.ObjectMeta{Name: "bench"},
+		ID:          "bench-1",
+		Tags:        []string{"a", "b", "c"},
+		Labels:      map[string]string{"key1": "val1", "key2": "val2"},
+		NestedObj:   Nested{Name: "nested", Info: Struct{S: "s", I: 1, B: true, F: 1.5}},
+		Timeout:     metav1.Duration{Duration: 5 * time.Second},
+		RawBytes:    []byte("bytes"),
+		ChildPtr:    &Struct{S: "child", I: 2, B: false, F: 2.5},
+		IntKeyedMap: map[int32]string{80: "http", 443: "https"},
+		IntOrString: intstr.FromInt32(5),
+		Quantity:    resource.MustParse("100m"),
+		I32:         32,
+		I64:         64,
+		F32:         32.5,
+		Enum:        EnumTypeA,
+	}
+}
+
+// BenchmarkStructVal_Get repeatedly accesses a mix of fields on the same
+// struct value, exercising cachedStructOf's per-type field index rather than
+// the per-field classification it memoizes.
+func BenchmarkStructVal_Get(b *testing.B) {
+	v := TypedToVal(benchComplex()).(traits.Mapper)
+	keys := []ref.Val{
+		types.String("id"), types.String("tags"), types.String("labels"),
+		types.String("nestedObj"), types.String("i32"), types.String("i64"),
+		types.String("f32"), types.String("enum"), types.String("quantity"),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, k := range keys {
+			v.Get(k)
+		}
+	}
+}
+
+func largeIntSlice(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func largeStringKeyedMap(n int) map[string]int {
+	m := make(map[string]int, n)
+	for i := 0; i < n; i++ {
+		m[fmt.Sprintf("key%d", i)] = i
+	}
+	return m
+}
+
+// BenchmarkSliceVal_IteratorFull walks every element of a large slice,
+// exercising sliceVal's lazy per-element conversion on Next().
+func BenchmarkSliceVal_IteratorFull(b *testing.B) {
+	v := TypedToVal(largeIntSlice(10000)).(traits.Lister)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it := v.Iterator()
+		for it.HasNext() == types.True {
+			it.Next()
+		}
+	}
+}
+
+// BenchmarkSliceVal_IteratorEarlyExit walks only the first element of a
+// large slice, demonstrating that a lazy iterator pays only for the
+// elements it actually visits.
+func BenchmarkSliceVal_IteratorEarlyExit(b *testing.B) {
+	v := TypedToVal(largeIntSlice(10000)).(traits.Lister)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it := v.Iterator()
+		if it.HasNext() == types.True {
+			it.Next()
+		}
+	}
+}
+
+// BenchmarkSliceVal_Get repeatedly indexes the same large slice, exercising
+// elemConverterOf's per-element-type cache.
+func BenchmarkSliceVal_Get(b *testing.B) {
+	v := TypedToVal(largeIntSlice(10000)).(traits.Lister)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.Get(types.Int(i % 10000))
+	}
+}
+
+// BenchmarkMapVal_IteratorFull walks every entry of a large map, exercising
+// mapVal's lazy per-key conversion on Next().
+func BenchmarkMapVal_IteratorFull(b *testing.B) {
+	v := TypedToVal(largeStringKeyedMap(10000)).(traits.Mapper)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it := v.Iterator()
+		for it.HasNext() == types.True {
+			it.Next()
+		}
+	}
+}
+
+// BenchmarkMapVal_Get repeatedly looks up the same key in a large map,
+// exercising elemConverterOf's per-element-type cache.
+func BenchmarkMapVal_Get(b *testing.B) {
+	v := TypedToVal(largeStringKeyedMap(10000)).(traits.Mapper)
+	key := types.String("key1")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.Get(key)
+	}
+}
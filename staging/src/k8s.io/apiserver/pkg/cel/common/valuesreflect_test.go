@@ -17,6 +17,13 @@ limitations under the License.
 package common
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
@@ -25,8 +32,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apiserver/pkg/cel/library"
-	"testing"
-	"time"
 )
 
 type Struct struct {
@@ -55,27 +60,36 @@ type Complex struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	ID          string             `json:"id"`
-	Tags        []string           `json:"tags"`
-	Labels      map[string]string  `json:"labels"`
-	NestedObj   Nested             `json:"nestedObj"`
-	Timeout     metav1.Duration    `json:"timeout"`
-	RawBytes    []byte             `json:"rawBytes"`
-	NilBytes    []byte             `json:"nilBytes"` // Always nil
-	ChildPtr    *Struct            `json:"childPtr"`
-	NilPtr      *Struct            `json:"nilPtr"` // Always nil
-	EmptySlice  []int              `json:"emptySlice"`
-	NilSlice    []int              `json:"nilSlice"` // Always nil
-	EmptyMap    map[string]int     `json:"emptyMap"`
-	NilMap      map[string]int     `json:"nilMap"` // Always nil
-	IntOrString intstr.IntOrString `json:"intOrString"`
-	Quantity    resource.Quantity  `json:"quantity"`
-	I32         int32              `json:"i32"`
-	I64         int64              `json:"i64"`
-	F32         float32            `json:"f32"`
-	Enum        EnumType           `json:"enum"`
+	ID           string              `json:"id"`
+	Tags         []string            `json:"tags"`
+	Labels       map[string]string   `json:"labels"`
+	NestedObj    Nested              `json:"nestedObj"`
+	Timeout      metav1.Duration     `json:"timeout"`
+	RawBytes     []byte              `json:"rawBytes"`
+	NilBytes     []byte              `json:"nilBytes"` // Always nil
+	ChildPtr     *Struct             `json:"childPtr"`
+	NilPtr       *Struct             `json:"nilPtr"` // Always nil
+	EmptySlice   []int               `json:"emptySlice"`
+	NilSlice     []int               `json:"nilSlice"` // Always nil
+	EmptyMap     map[string]int      `json:"emptyMap"`
+	NilMap       map[string]int      `json:"nilMap"` // Always nil
+	IntKeyedMap  map[int32]string    `json:"intKeyedMap"`
+	UintKeyedMap map[uint32]string   `json:"uintKeyedMap"`
+	NamedKeyMap  map[LabelKey]string `json:"namedKeyMap"`
+	IntOrString  intstr.IntOrString  `json:"intOrString"`
+	Quantity     resource.Quantity   `json:"quantity"`
+	I32          int32               `json:"i32"`
+	I64          int64               `json:"i64"`
+	F32          float32             `json:"f32"`
+	Enum         EnumType            `json:"enum"`
 }
 
+// LabelKey is a named string type, modeled on Kubernetes types like
+// ResourceName or QualifiedName that are string aliases rather than the
+// bare "string" type, used here as a map key to exercise nativeMapKey's
+// reflect.Convert-based (not just exact-type) key matching.
+type LabelKey string
+
 type EnumType string
 
 const (
@@ -83,6 +97,13 @@ const (
 	EnumTypeB EnumType = "b"
 )
 
+func init() {
+	RegisterEnum(reflect.TypeOf(EnumType("")), map[string]string{
+		"EnumTypeA": string(EnumTypeA),
+		"EnumTypeB": string(EnumTypeB),
+	})
+}
+
 func typedToValActivation(vals map[string]interface{}) map[string]interface{} {
 	activation := make(map[string]interface{}, len(vals))
 	for k, v := range vals {
@@ -98,6 +119,17 @@ type testCase struct {
 	wantErr    string
 }
 
+// goldenTopics lists the testdata/*.golden files that back TestTypedToVal,
+// one per section of the corpus. Add a new file here when adding a topic.
+var goldenTopics = []string{"basic", "structs", "comparisons", "nested", "slices", "maps", "pointers", "types", "k8s_types", "intorstring", "mapentries", "optionalpath", "enum"}
+
+// TestTypedToVal evaluates the named CEL expressions in testdata/*.golden
+// against a registry of Go activations built below, keyed by golden case
+// name. Expressions and expected results live in the golden files so
+// contributors can add regression fixtures without editing this function;
+// run with -update to regenerate a file's expected results from a live
+// evaluation (e.g. after adding a new "<<< name" block with its expression
+// filled in and its output left blank).
 func TestTypedToVal(t *testing.T) {
 	struct1 := Struct{S: "hello", I: 10, B: true, F: 1.5}
 	struct1Ptr := &struct1
@@ -114,50 +146,56 @@ func TestTypedToVal(t *testing.T) {
 	nested1 := Nested{Name: "nested1", Info: struct1}
 
 	complex1 := Complex{
-		TypeMeta:    metav1.TypeMeta{Kind: "Complex", APIVersion: "v1"},
-		ObjectMeta:  metav1.ObjectMeta{Name: "complex1"},
-		ID:          "c1",
-		Tags:        []string{"a", "b", "c"},
-		Labels:      map[string]string{"key1": "val1", "key2": "val2"},
-		NestedObj:   nested1,
-		Timeout:     duration1,
-		RawBytes:    []byte("bytes1"),
-		NilBytes:    nil,
-		ChildPtr:    &struct2,
-		NilPtr:      nil,
-		EmptySlice:  []int{},
-		NilSlice:    nil,
-		EmptyMap:    map[string]int{},
-		NilMap:      nil,
-		IntOrString: intstr.FromInt32(5),
-		Quantity:    resource.MustParse("100m"),
-		I32:         int32(32),
-		I64:         int64(64),
-		F32:         float32(32.5),
-		Enum:        EnumTypeA,
+		TypeMeta:     metav1.TypeMeta{Kind: "Complex", APIVersion: "v1"},
+		ObjectMeta:   metav1.ObjectMeta{Name: "complex1"},
+		ID:           "c1",
+		Tags:         []string{"a", "b", "c"},
+		Labels:       map[string]string{"key1": "val1", "key2": "val2"},
+		NestedObj:    nested1,
+		Timeout:      duration1,
+		RawBytes:     []byte("bytes1"),
+		NilBytes:     nil,
+		ChildPtr:     &struct2,
+		NilPtr:       nil,
+		EmptySlice:   []int{},
+		NilSlice:     nil,
+		EmptyMap:     map[string]int{},
+		NilMap:       nil,
+		IntKeyedMap:  map[int32]string{80: "http", 443: "https"},
+		UintKeyedMap: map[uint32]string{8080: "alt-http"},
+		NamedKeyMap:  map[LabelKey]string{"env": "prod"},
+		IntOrString:  intstr.FromInt32(5),
+		Quantity:     resource.MustParse("100m"),
+		I32:          int32(32),
+		I64:          int64(64),
+		F32:          float32(32.5),
+		Enum:         EnumTypeA,
 	}
 	complex2 := Complex{
-		TypeMeta:    metav1.TypeMeta{Kind: "Complex2", APIVersion: "v1"},
-		ObjectMeta:  metav1.ObjectMeta{Name: "complex2"},
-		ID:          "c2",
-		Tags:        []string{"x", "y"},
-		Labels:      map[string]string{"key3": "val3"},
-		NestedObj:   Nested{Name: "nested2", Info: struct2},
-		Timeout:     metav1.Duration{Duration: 10 * time.Second},
-		RawBytes:    []byte("bytes2"),
-		NilBytes:    []byte{}, // Non-nil but empty
-		ChildPtr:    &struct1,
-		NilPtr:      nil,
-		EmptySlice:  []int{1},               // Non-empty
-		NilSlice:    []int{1},               // Non-nil
-		EmptyMap:    map[string]int{"a": 1}, // Non-empty
-		NilMap:      map[string]int{"a": 1}, // Non-nil
-		IntOrString: intstr.FromString("port"),
-		Quantity:    resource.MustParse("200m"),
-		I32:         int32(42),
-		I64:         int64(200),
-		F32:         float32(42.5),
-		Enum:        EnumTypeB,
+		TypeMeta:     metav1.TypeMeta{Kind: "Complex2", APIVersion: "v1"},
+		ObjectMeta:   metav1.ObjectMeta{Name: "complex2"},
+		ID:           "c2",
+		Tags:         []string{"x", "y"},
+		Labels:       map[string]string{"key3": "val3"},
+		NestedObj:    Nested{Name: "nested2", Info: struct2},
+		Timeout:      metav1.Duration{Duration: 10 * time.Second},
+		RawBytes:     []byte("bytes2"),
+		NilBytes:     []byte{}, // Non-nil but empty
+		ChildPtr:     &struct1,
+		NilPtr:       nil,
+		EmptySlice:   []int{1},                           // Non-empty
+		NilSlice:     []int{1},                           // Non-nil
+		EmptyMap:     map[string]int{"a": 1},             // Non-empty
+		NilMap:       map[string]int{"a": 1},             // Non-nil
+		IntKeyedMap:  map[int32]string{8080: "alt-http"}, // Different key set
+		UintKeyedMap: map[uint32]string{9090: "other"},
+		NamedKeyMap:  map[LabelKey]string{"env": "dev"},
+		IntOrString:  intstr.FromString("port"),
+		Quantity:     resource.MustParse("200m"),
+		I32:          int32(42),
+		I64:          int64(200),
+		F32:          float32(42.5),
+		Enum:         EnumTypeB,
 	}
 	complex1Again := complex1 // Create a copy for equality checks
 
@@ -172,621 +210,790 @@ func TestTypedToVal(t *testing.T) {
 	map3 := map[string]int{"a": 1, "c": 2}        // Different key
 	map4 := map[string]string{"a": "1", "b": "2"} // Different value type
 
-	tests := []testCase{
-		// Basic Type Conversions
-		{
-			name:       "basic: int32",
-			expression: "c.i32 == 32",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "basic: int64",
-			expression: "c.i64 == 64",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "basic: float32",
-			expression: "c.f32 == 32.5",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "basic: enum",
-			expression: "c.enum == 'a'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "basic: nil bytes",
-			expression: "c.nilBytes == null",
-			activation: map[string]interface{}{"c": complex1},
-		},
+	activations := map[string]map[string]interface{}{
+		"basic_int32":     {"c": complex1},
+		"basic_int64":     {"c": complex1},
+		"basic_float32":   {"c": complex1},
+		"basic_enum":      {"c": complex1},
+		"basic_nil_bytes": {"c": complex1},
 
-		// Struct Tests
-		{
-			name:       "struct: zero value struct",
-			expression: "obj.s == '' && obj.i == 0 && obj.b == false && obj.f == 0.0",
-			activation: map[string]interface{}{"obj": zeroStruct},
-		},
-		{
-			name:       "struct: zero value struct pointer",
-			expression: "obj.s == '' && obj.i == 0 && obj.b == false && obj.f == 0.0",
-			activation: map[string]interface{}{"obj": zeroStructPtr},
-		},
-		{
-			name:       "struct: populated struct jsonTag access",
-			expression: "obj.s == 'hello' && obj.i == 10 && obj.b == true && obj.f == 1.5",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "struct: populated struct pointer jsonTag access",
-			expression: "obj.s == 'hello' && obj.i == 10 && obj.b == true && obj.f == 1.5",
-			activation: map[string]interface{}{"obj": struct1Ptr},
-		},
-		{
-			name:       "struct: access omitempty jsonTag (has)",
-			expression: "!has(obj.s)",
-			activation: map[string]interface{}{"obj": structOmitEmpty1},
-		},
-		{
-			name:       "struct: access non-existent jsonTag (has)",
-			expression: "!has(obj.nonExistent)",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "struct: access non-existent jsonTag direct (error)",
-			expression: "obj.nonExistent",
-			activation: map[string]interface{}{"obj": struct1},
-			wantErr:    "no such key: nonExistent",
-		},
-		{
-			name:       "struct: access with non-string key (get) (error)",
-			expression: "obj[1]",
-			activation: map[string]interface{}{"obj": struct1},
-			wantErr:    "no such overload",
-		},
-		{
-			name:       "struct: check contains non-string key (error)",
-			expression: "1 in obj",
-			activation: map[string]interface{}{"obj": struct1},
-			wantErr:    "no such overload",
-		},
-		{
-			name:       "struct: convert to its own type",
-			expression: "type(obj) == type(obj)",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "struct: embedded inline",
-			expression: "c.apiVersion == 'v1' && c.kind == 'Complex'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "struct: embedded inline: omitempty",
-			expression: "!has(c.apiVersion)",
-			activation: map[string]interface{}{"c": structOmitEmpty1},
-		},
-		{
-			name:       "struct: embedded struct",
-			expression: "c.metadata.name == 'complex1'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "struct: embedded struct: omitempty struct field",
-			expression: "!has(c.metadata.labels)",
-			activation: map[string]interface{}{"c": complex1},
-		},
+		"struct_zero_value_struct":                       {"obj": zeroStruct},
+		"struct_zero_value_struct_pointer":               {"obj": zeroStructPtr},
+		"struct_populated_jsontag_access":                {"obj": struct1},
+		"struct_populated_pointer_jsontag_access":        {"obj": struct1Ptr},
+		"struct_access_omitempty_jsontag_has":            {"obj": structOmitEmpty1},
+		"struct_access_nonexistent_jsontag_has":          {"obj": struct1},
+		"struct_access_nonexistent_jsontag_direct_error": {"obj": struct1},
+		"struct_access_nonstring_key_get_error":          {"obj": struct1},
+		"struct_contains_nonstring_key_error":            {"obj": struct1},
+		"struct_convert_to_own_type":                     {"obj": struct1},
+		"struct_embedded_inline":                         {"c": complex1},
+		"struct_embedded_inline_omitempty":               {"c": structOmitEmpty1},
+		"struct_embedded_struct":                         {"c": complex1},
+		"struct_embedded_struct_omitempty_field":         {"c": complex1},
 
-		// Comparison Tests
-		{
-			name:       "compare: identity (struct)",
-			expression: "s1 == s1",
-			activation: map[string]interface{}{"s1": struct1},
-		},
-		{
-			name:       "compare: identical structs",
-			expression: "s1 == s1_again",
-			activation: map[string]interface{}{"s1": struct1, "s1_again": struct1Again},
-		},
-		{
-			name:       "compare: different structs",
-			expression: "s1 != s2",
-			activation: map[string]interface{}{"s1": struct1, "s2": struct2},
-		},
-		{
-			name:       "compare: struct and pointer to identical struct",
-			expression: "s1 == s1_ptr",
-			activation: map[string]interface{}{"s1": struct1, "s1_ptr": struct1Ptr},
-		},
-		{
-			name:       "compare: struct and nil",
-			expression: "s1 != null",
-			activation: map[string]interface{}{"s1": struct1},
-		},
-		{
-			name:       "compare: struct and different type",
-			expression: "s1 != 10",
-			activation: map[string]interface{}{"s1": struct1},
-		},
-		{
-			name:       "compare: nil struct pointer and null",
-			expression: "nil_obj == null",
-			activation: map[string]interface{}{"nil_obj": (*Struct)(nil)},
-		},
-		{
-			name:       "compare: identical complex structs",
-			expression: "c1 == c1_again",
-			activation: map[string]interface{}{"c1": complex1, "c1_again": complex1Again},
-		},
-		{
-			name:       "compare: different complex structs",
-			expression: "c1 != c2",
-			activation: map[string]interface{}{"c1": complex1, "c2": complex2},
-		},
-		{
-			name:       "compare: identical slices (activation)",
-			expression: "sl1 == sl1a",
-			activation: map[string]interface{}{"sl1": slice1, "sl1a": slice1Again},
-		},
-		{
-			name:       "compare: different slices (activation)",
-			expression: "sl1 != sl2",
-			activation: map[string]interface{}{"sl1": slice1, "sl2": slice2},
-		},
-		{
-			name:       "compare: slices of different types",
-			expression: "sl1 != sl3",
-			activation: map[string]interface{}{"sl1": slice1, "sl3": slice3},
-		},
-		{
-			name:       "compare: slice and non-list",
-			expression: "sl1 != 1",
-			activation: map[string]interface{}{"sl1": slice1},
-		},
-		{
-			name:       "compare: identical maps (activation)",
-			expression: "m1 == m1a",
-			activation: map[string]interface{}{"m1": map1, "m1a": map1Again},
-		},
-		{
-			name:       "compare: different maps (value) (activation)",
-			expression: "m1 != m2",
-			activation: map[string]interface{}{"m1": map1, "m2": map2},
-		},
-		{
-			name:       "compare: different maps (key) (activation)",
-			expression: "m1 != m3",
-			activation: map[string]interface{}{"m1": map1, "m3": map3},
-		},
-		{
-			name:       "compare: different maps (value type)",
-			expression: "m1 != m4",
-			activation: map[string]interface{}{"m1": map1, "m4": map4},
-		},
-		{
-			name:       "compare: map and non-map",
-			expression: "m1 != 1",
-			activation: map[string]interface{}{"m1": map1},
-		},
-		{
-			name:       "compare: time instances (equal)",
-			expression: "t1 == t2",
-			activation: map[string]interface{}{"t1": now, "t2": now},
-		},
-		{
-			name:       "compare: time instances (different)",
-			expression: "t1 != t2",
-			activation: map[string]interface{}{"t1": now, "t2": metav1.Time{Time: now.Add(time.Nanosecond)}},
-		},
-		{
-			name:       "compare: duration instances (equal)",
-			expression: "d1 == d2",
-			activation: map[string]interface{}{"d1": duration1, "d2": metav1.Duration{Duration: 5 * time.Second}},
-		},
-		{
-			name:       "compare: duration instances (different)",
-			expression: "d1 != d2",
-			activation: map[string]interface{}{"d1": duration1, "d2": metav1.Duration{Duration: 6 * time.Second}},
-		},
-		{
-			name:       "compare: bytes instances (equal)",
-			expression: "b1 == b2",
-			activation: map[string]interface{}{"b1": []byte("abc"), "b2": []byte("abc")},
-		},
-		{
-			name:       "compare: bytes instances (different)",
-			expression: "b1 != b2",
-			activation: map[string]interface{}{"b1": []byte("abc"), "b2": []byte("abd")},
-		},
-		{
-			name:       "compare: empty slices (different underlying types)",
-			expression: "e1 == e2",
-			activation: map[string]interface{}{"e1": []int{}, "e2": []string(nil)},
-		},
-		{
-			name:       "compare: empty maps (different underlying types)",
-			expression: "m1 == m2",
-			activation: map[string]interface{}{"m1": map[string]int{}, "m2": map[string]bool(nil)},
-		},
+		"compare_identity_struct":              {"s1": struct1},
+		"compare_identical_structs":            {"s1": struct1, "s1_again": struct1Again},
+		"compare_different_structs":            {"s1": struct1, "s2": struct2},
+		"compare_struct_and_pointer_identical": {"s1": struct1, "s1_ptr": struct1Ptr},
+		"compare_struct_and_nil":               {"s1": struct1},
+		"compare_struct_and_different_type":    {"s1": struct1},
+		"compare_nil_struct_pointer_and_null":  {"nil_obj": (*Struct)(nil)},
+		"compare_identical_complex_structs":    {"c1": complex1, "c1_again": complex1Again},
+		"compare_different_complex_structs":    {"c1": complex1, "c2": complex2},
+		"compare_identical_slices":             {"sl1": slice1, "sl1a": slice1Again},
+		"compare_different_slices":             {"sl1": slice1, "sl2": slice2},
+		"compare_slices_different_types":       {"sl1": slice1, "sl3": slice3},
+		"compare_slice_and_nonlist":            {"sl1": slice1},
+		"compare_identical_maps":               {"m1": map1, "m1a": map1Again},
+		"compare_different_maps_value":         {"m1": map1, "m2": map2},
+		"compare_different_maps_key":           {"m1": map1, "m3": map3},
+		"compare_different_maps_value_type":    {"m1": map1, "m4": map4},
+		"compare_map_and_nonmap":               {"m1": map1},
+		"compare_time_equal":                   {"t1": now, "t2": now},
+		"compare_time_different":               {"t1": now, "t2": metav1.Time{Time: now.Add(time.Nanosecond)}},
+		"compare_duration_equal":               {"d1": duration1, "d2": metav1.Duration{Duration: 5 * time.Second}},
+		"compare_duration_different":           {"d1": duration1, "d2": metav1.Duration{Duration: 6 * time.Second}},
+		"compare_bytes_equal":                  {"b1": []byte("abc"), "b2": []byte("abc")},
+		"compare_bytes_different":              {"b1": []byte("abc"), "b2": []byte("abd")},
+		"compare_empty_slices_different_types": {"e1": []int{}, "e2": []string(nil)},
+		"compare_empty_maps_different_types":   {"m1": map[string]int{}, "m2": map[string]bool(nil)},
 
-		// Nested Struct Tests
-		{
-			name:       "nested: access jsonTag",
-			expression: "c.nestedObj.info.s == 'hello'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "nested: compare nested struct",
-			expression: "c1.nestedObj != c2.nestedObj",
-			activation: map[string]interface{}{"c1": complex1, "c2": complex2},
-		},
-		{
-			name:       "nested: compare identical nested struct",
-			expression: "c1.nestedObj == c1_again.nestedObj",
-			activation: map[string]interface{}{"c1": complex1, "c1_again": complex1Again},
-		},
+		"nested_access_jsontag":    {"c": complex1},
+		"nested_compare_different": {"c1": complex1, "c2": complex2},
+		"nested_compare_identical": {"c1": complex1, "c1_again": complex1Again},
 
-		// Slice Tests
-		{
-			name:       "slice: access element",
-			expression: "c.tags[1] == 'b'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: size",
-			expression: "size(c.tags) == 3",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: contains ('in')",
-			expression: "'b' in c.tags",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: not contains ('in')",
-			expression: "!('d' in c.tags)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: contains with non-primitive (struct)",
-			expression: "s1 in structs",
-			activation: map[string]interface{}{"structs": []Struct{struct2, struct1}, "s1": struct1},
-		},
-		{
-			name:       "slice: contains with non-primitive (struct ptr)",
-			expression: "s1 in structs",
-			activation: map[string]interface{}{"structs": []*Struct{&struct2, &struct1}, "s1": &struct1},
-		},
-		{
-			name:       "slice: add",
-			expression: "size(c1.tags + c2.tags) == 5 && (c1.tags + c2.tags)[3] == 'x'",
-			activation: map[string]interface{}{"c1": complex1, "c2": complex2},
-		},
-		{
-			name:       "slice: add non-list (error)",
-			expression: "c.tags + 1",
-			activation: map[string]interface{}{"c": complex1},
-			wantErr:    "no such overload",
-		},
-		{
-			name:       "slice: get with non-int index (error)",
-			expression: `c.tags['a']`,
-			activation: map[string]interface{}{"c": complex1},
-			wantErr:    `unsupported index type 'string' in list`,
-		},
-		{
-			name:       "slice: all() true",
-			expression: "c.tags.all(t, t.startsWith(''))",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: all() false",
-			expression: "!c.tags.all(t, t == 'a')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: exists() true",
-			expression: "c.tags.exists(t, t == 'c')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: exists() false",
-			expression: "!c.tags.exists(t, t == 'z')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: out of bounds access",
-			expression: "c.tags[5]",
-			activation: map[string]interface{}{"c": complex1},
-			wantErr:    "index out of bounds: 5",
-		},
-		{
-			name:       "slice: empty slice size",
-			expression: "size(c.emptySlice) == 0",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: nil slice size",
-			expression: "size(c.nilSlice) == 0",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: exists() on empty",
-			expression: "!c.emptySlice.exists(x, true)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: all() on empty",
-			expression: "c.emptySlice.all(x, false)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: convert to list type",
-			expression: "type(c.tags) == list",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "slice: convert list to type type",
-			expression: "type(c.tags) == list",
-			activation: map[string]interface{}{"c": complex1},
-		},
+		"slice_access_element":            {"c": complex1},
+		"slice_size":                      {"c": complex1},
+		"slice_contains_in":               {"c": complex1},
+		"slice_not_contains_in":           {"c": complex1},
+		"slice_contains_struct":           {"structs": []Struct{struct2, struct1}, "s1": struct1},
+		"slice_contains_struct_ptr":       {"structs": []*Struct{&struct2, &struct1}, "s1": &struct1},
+		"slice_add":                       {"c1": complex1, "c2": complex2},
+		"slice_add_nonlist_error":         {"c": complex1},
+		"slice_get_nonint_index_error":    {"c": complex1},
+		"slice_all_true":                  {"c": complex1},
+		"slice_all_false":                 {"c": complex1},
+		"slice_exists_true":               {"c": complex1},
+		"slice_exists_false":              {"c": complex1},
+		"slice_out_of_bounds":             {"c": complex1},
+		"slice_empty_size":                {"c": complex1},
+		"slice_nil_size":                  {"c": complex1},
+		"slice_exists_on_empty":           {"c": complex1},
+		"slice_all_on_empty":              {"c": complex1},
+		"slice_convert_to_list_type":      {"c": complex1},
+		"slice_convert_list_to_type_type": {"c": complex1},
 
-		// Map Tests
-		{
-			name:       "map: access element",
-			expression: "c.labels['key1'] == 'val1'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: size",
-			expression: "size(c.labels) == 2",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: contains key ('in')",
-			expression: "'key1' in c.labels",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: not contains key ('in')",
-			expression: "!('key3' in c.labels)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: has() key",
-			expression: "has(c.labels.key1)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: has() non-existent key",
-			expression: "!has(c.labels.key3)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: access non-existent key (error)",
-			expression: "c.labels['key3']",
-			activation: map[string]interface{}{"c": complex1},
-			wantErr:    "no such key: key3",
-		},
-		{
-			name:       "map: all() on keys true",
-			expression: "c.labels.all(name, name.startsWith('key'))",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: all() on keys false",
-			expression: "!c.labels.all(name, name == 'key1')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: exists() on keys true",
-			expression: "c.labels.exists(name, name == 'key2')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: exists() on keys false",
-			expression: "!c.labels.exists(name, name == 'key3')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: empty map size",
-			expression: "size(c.emptyMap) == 0",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: nil map size",
-			expression: "size(c.nilMap) == 0",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: exists() on empty",
-			expression: "!c.emptyMap.exists(name, true)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: all() on empty",
-			expression: "c.emptyMap.all(name, false)",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: convert to map type",
-			expression: "type(c.labels) == map",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "map: convert map to type type",
-			expression: "type(c.labels) == map",
-			activation: map[string]interface{}{"c": complex1},
-		},
+		"map_access_element":               {"c": complex1},
+		"map_size":                         {"c": complex1},
+		"map_contains_key":                 {"c": complex1},
+		"map_not_contains_key":             {"c": complex1},
+		"map_has_key":                      {"c": complex1},
+		"map_has_nonexistent_key":          {"c": complex1},
+		"map_access_nonexistent_key_error": {"c": complex1},
+		"map_all_keys_true":                {"c": complex1},
+		"map_all_keys_false":               {"c": complex1},
+		"map_exists_keys_true":             {"c": complex1},
+		"map_exists_keys_false":            {"c": complex1},
+		"map_empty_size":                   {"c": complex1},
+		"map_nil_size":                     {"c": complex1},
+		"map_exists_on_empty":              {"c": complex1},
+		"map_all_on_empty":                 {"c": complex1},
+		"map_convert_to_map_type":          {"c": complex1},
+		"map_convert_map_to_type_type":     {"c": complex1},
 
-		// Pointer Tests
-		{
-			name:       "pointer: access through non-nil pointer jsonTag",
-			expression: "c.childPtr.s == 'world'",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "pointer: compare non-nil pointer jsonTag",
-			expression: "c.childPtr == s2",
-			activation: map[string]interface{}{"c": complex1, "s2": struct2},
-		},
-		{
-			name:       "pointer: access through nil pointer jsonTag (error)",
-			expression: "c.nilPtr.s",
-			activation: map[string]interface{}{"c": complex1},
-			wantErr:    "no such key: s", // Accessing jsonTag 's' on a null object
-		},
-		{
-			name:       "pointer: check if nil pointer jsonTag is null",
-			expression: "c.nilPtr == null",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "pointer: has() on nil pointer jsonTag subfield",
-			expression: "!has(c.nilPtr.s)",
-			activation: map[string]interface{}{"c": complex1},
-		},
+		"pointer_access_nonnil":       {"c": complex1},
+		"pointer_compare_nonnil":      {"c": complex1, "s2": struct2},
+		"pointer_access_nil_error":    {"c": complex1},
+		"pointer_check_nil_is_null":   {"c": complex1},
+		"pointer_has_on_nil_subfield": {"c": complex1},
 
-		// Type Tests
-		{
-			name:       "type: string jsonTag",
-			expression: "type(obj.s) == string",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "type: int jsonTag",
-			expression: "type(obj.i) == int",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "type: bool jsonTag",
-			expression: "type(obj.b) == bool",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "type: float jsonTag",
-			expression: "type(obj.f) == double",
-			activation: map[string]interface{}{"obj": struct1},
-		},
-		{
-			name:       "type: slice jsonTag",
-			expression: "type(c.tags) == list",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "type: map jsonTag",
-			expression: "type(c.labels) == map",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "type: duration jsonTag",
-			expression: "type(c.timeout) == google.protobuf.Duration",
-			activation: map[string]interface{}{"c": complex1},
-		},
+		"type_string":      {"obj": struct1},
+		"type_int":         {"obj": struct1},
+		"type_bool":        {"obj": struct1},
+		"type_float":       {"obj": struct1},
+		"type_slice":       {"c": complex1},
+		"type_map":         {"c": complex1},
+		"type_duration":    {"c": complex1},
+		"type_bytes":       {"c": complex1},
+		"type_nil_pointer": {"c": complex1},
+		"type_int32":       {"c": complex1},
+		"type_int64":       {"c": complex1},
+		"type_float32":     {"c": complex1},
+		"type_enum":        {"c": complex1},
+
+		"k8s_duration_eq":         {"c": complex1},
+		"k8s_duration_gt":         {"c": complex1},
+		"k8s_intorstring_int":     {"c": complex1},
+		"k8s_intorstring_string":  {"c": complex2},
+		"k8s_quantity_comparison": {"c": complex1},
+		"k8s_quantity_equality":   {"c": complex1},
+		"k8s_bytes_size":          {"c": complex1},
+		"k8s_bytes_equality":      {"c": complex1},
+
+		"intorstring_type":                      {"c": complex1},
+		"intorstring_eq_int":                    {"c": complex1},
+		"intorstring_eq_string":                 {"c": complex2},
+		"intorstring_eq_identical":              {"c1": complex1, "c2": complex1Again},
+		"intorstring_ne_different":              {"c1": complex1, "c2": complex2},
+		"intorstring_int_value":                 {"c": complex1},
+		"intorstring_str_value":                 {"c": complex2},
+		"intorstring_int_value_on_string_error": {"c": complex2},
+		"intorstring_str_value_on_int_error":    {"c": complex1},
+		"intorstring_is_int_true":               {"c": complex1},
+		"intorstring_is_int_false":              {"c": complex2},
+		"intorstring_compare_int":               {"c": complex1},
+		"intorstring_compare_string":            {"c": complex2},
+		"intorstring_add_int":                   {"c": complex1},
+		"intorstring_add_string":                {"c": complex2},
+
+		"mapentries_keys_string":                     {"c": complex1},
+		"mapentries_keys_int":                        {"c": complex1},
+		"mapentries_keys_size":                       {"c": complex1},
+		"mapentries_keys_empty":                      {"c": complex1},
+		"mapentries_keys_nil":                        {"c": complex1},
+		"mapentries_entries_correlate_value":         {"c": complex1},
+		"mapentries_entries_int_key_correlate_value": {"c": complex1},
+		"mapentries_entries_size":                    {"c": complex1},
+		"mapentries_iterate_binds_int_key":           {"c": complex1},
+		"mapentries_int_keyed_equal":                 {"c1": complex1, "c2": complex1Again},
+		"mapentries_int_keyed_not_equal":             {"c1": complex1, "c2": complex2},
+
+		"map_uint_keyed_access":         {"c": complex1},
+		"map_uint_keyed_size":           {"c": complex1},
+		"map_uint_keyed_type":           {"c": complex1},
+		"map_named_string_keyed_access": {"c": complex1},
+		"map_named_string_keyed_type":   {"c": complex1},
+
+		"optionalpath_present_nested":           {"c": complex1},
+		"optionalpath_nil_ptr_absent":           {"c": complex1},
+		"optionalpath_nil_map_key_absent":       {"c": complex1},
+		"optionalpath_empty_slice_index_absent": {"c": complex1},
+		"optionalpath_zero_value_present":       {"obj": zeroStruct},
+		"optionalpath_omitempty_absent":         {"obj": structOmitEmpty1},
+		"optionalpath_malformed_index_error":    {"c": complex1},
+
+		"enum_symbolic_equal":       {"c": complex1},
+		"enum_string_literal_equal": {"c": complex1},
+		"enum_symbolic_not_equal":   {"c1": complex1, "c2": complex2},
+		"enum_in_list":              {"c": complex1},
+	}
+
+	for _, topic := range goldenTopics {
+		entries := loadGolden(t, topic)
+		updated := false
+		for i := range entries {
+			entry := entries[i]
+			activation, ok := activations[entry.Name]
+			if !ok {
+				t.Fatalf("%s: no activation registered for golden case %q", topic, entry.Name)
+			}
+			t.Run(entry.Name, func(t *testing.T) {
+				var opts []cel.EnvOption
+				for k := range activation {
+					opts = append(opts, cel.Variable(k, cel.DynType))
+				}
+				opts = append(opts, cel.StdLib(), library.Quantity(), IntOrString(), MapEntries(), OptionalPath(), EnumTypes(reflect.TypeOf(EnumType(""))))
+
+				env, err := cel.NewEnv(opts...)
+				if err != nil {
+					t.Fatalf("Env creation error: %v", err)
+				}
+
+				typedOut, typedErr := evalExpression(t, env, entry.Expression, typedToValActivation(activation))
+
+				if *update {
+					entries[i].Output = goldenOutputFor(typedOut, typedErr)
+					updated = true
+					return
+				}
+
+				wantErr := strings.TrimPrefix(entry.Output, "ERROR: ")
+				if wantErr != entry.Output {
+					if typedErr == nil {
+						t.Fatalf("Expected error '%s' during evaluation, but got none", wantErr)
+					}
+					if typedErr.Error() != wantErr {
+						t.Fatalf("Expected error '%s' during evaluation, but got: %v", wantErr, typedErr)
+					}
+					return
+				}
+				if typedErr != nil {
+					t.Fatalf("Unexpected err: %v", typedErr)
+				}
+				if typedOut != types.True {
+					t.Error(failureWithDiff(activation, typedOut))
+				}
+			})
+		}
+		if updated {
+			writeGolden(t, topic, entries)
+		}
+	}
+}
+
+// TestEnumUnknownNameCompileError verifies that comparing against a
+// misspelled or otherwise unregistered enum symbolic name fails when the
+// expression is compiled, not when it is evaluated: EnumTypes only declares
+// a CEL constant for each name actually registered via RegisterEnum, so an
+// undeclared identifier like EnumTypeTypo is rejected by env.Compile the
+// same way any other unknown identifier would be -- there is nothing enum
+// specific for evalExpression (which treats a compile error as fatal) to
+// special-case.
+func TestEnumUnknownNameCompileError(t *testing.T) {
+	env, err := cel.NewEnv(
+		cel.Variable("c", cel.DynType),
+		cel.StdLib(),
+		EnumTypes(reflect.TypeOf(EnumType(""))),
+	)
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+
+	_, iss := env.Compile("c.enum == EnumTypeTypo")
+	if iss.Err() == nil {
+		t.Fatal("expected a compile error for the unregistered name EnumTypeTypo, got none")
+	}
+	if !strings.Contains(iss.Err().Error(), "undeclared reference to 'EnumTypeTypo'") {
+		t.Fatalf("expected an undeclared-reference compile error, got: %v", iss.Err())
+	}
+}
+
+// UUID is a toy user-defined type used to exercise WithTransformer: a fixed
+// size byte array, which reflect-based conversion would otherwise expose as
+// a CEL list of uint, not as a single scalar value.
+type UUID [2]byte
+
+func (u UUID) String() string {
+	return fmt.Sprintf("%02x%02x", u[0], u[1])
+}
+
+type WithUUID struct {
+	ID   UUID   `json:"id"`
+	Name string `json:"name"`
+}
+
+// uuidTransformer converts a UUID to its hex string representation, so that
+// it behaves as a CEL string rather than a reflected fixed-size array.
+func uuidTransformer(val interface{}) ref.Val {
+	return types.String(val.(UUID).String())
+}
+
+func TestNewConverterWithTransformer(t *testing.T) {
+	converter := NewConverter(WithTransformer(reflect.TypeOf(UUID{}), uuidTransformer))
+
+	u1 := UUID{0x01, 0x02}
+	u1Again := UUID{0x01, 0x02}
+	u2 := UUID{0x03, 0x04}
+
+	tests := []testCase{
 		{
-			name:       "type: bytes jsonTag",
-			expression: "type(c.rawBytes) == bytes",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "transformer: top-level value type() and equality",
+			expression: "type(u) == string && u == '0102'",
+			activation: map[string]interface{}{"u": u1},
 		},
 		{
-			name:       "type: nil pointer jsonTag",
-			expression: "type(c.nilPtr) == null_type",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "transformer: equal values",
+			expression: "u1 == u2",
+			activation: map[string]interface{}{"u1": u1, "u2": u1Again},
 		},
 		{
-			name:       "type: int32 jsonTag",
-			expression: "type(c.i32) == int",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "transformer: different values",
+			expression: "u1 != u2",
+			activation: map[string]interface{}{"u1": u1, "u2": u2},
 		},
 		{
-			name:       "type: int64 jsonTag",
-			expression: "type(c.i64) == int",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "transformer: nested field has() and access",
+			expression: "has(obj.id) && obj.id == '0102'",
+			activation: map[string]interface{}{"obj": WithUUID{ID: u1, Name: "n1"}},
 		},
 		{
-			name:       "type: float32 jsonTag",
-			expression: "type(c.f32) == double",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "transformer: nested in slice",
+			expression: "'0102' in ids && size(ids) == 2",
+			activation: map[string]interface{}{"ids": []UUID{u1, u2}},
 		},
 		{
-			name:       "type: enum jsonTag",
-			expression: "type(c.enum) == string",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "transformer: nested in map value",
+			expression: "m['a'] == '0102'",
+			activation: map[string]interface{}{"m": map[string]UUID{"a": u1}},
 		},
+	}
 
-		// Special K8s Types
-		{
-			name:       "duration: comparison equals",
-			expression: "c.timeout == duration('5s')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "duration: comparison greater",
-			expression: "c.timeout > duration('1s')",
-			activation: map[string]interface{}{"c": complex1},
-		},
-		{
-			name:       "intOrString: int comparison",
-			expression: "c.intOrString == 5",
-			activation: map[string]interface{}{"c": complex1},
-		},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []cel.EnvOption
+			for k := range tt.activation {
+				opts = append(opts, cel.Variable(k, cel.DynType))
+			}
+			opts = append(opts, cel.StdLib(), library.Quantity())
+
+			env, err := cel.NewEnv(opts...)
+			if err != nil {
+				t.Fatalf("Env creation error: %v", err)
+			}
+
+			activation := make(map[string]interface{}, len(tt.activation))
+			for k, v := range tt.activation {
+				activation[k] = converter(v)
+			}
+
+			out, evalErr := evalExpression(t, env, tt.expression, activation)
+			if evalErr != nil {
+				t.Fatalf("Unexpected err: %v", evalErr)
+			}
+			if out != types.True {
+				t.Errorf("Expected true but got %v", out)
+			}
+		})
+	}
+}
+
+// TestNewConverterDefault confirms that NewConverter(), with no options
+// registered, converts values identically to TypedToVal.
+func TestNewConverterDefault(t *testing.T) {
+	converter := NewConverter()
+	struct1 := Struct{S: "hello", I: 10, B: true, F: 1.5}
+
+	got := converter(struct1)
+	want := TypedToVal(struct1)
+
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.StdLib())
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+	out, evalErr := evalExpression(t, env, "a == b", map[string]interface{}{"a": got, "b": want})
+	if evalErr != nil {
+		t.Fatalf("Unexpected err: %v", evalErr)
+	}
+	if out != types.True {
+		t.Errorf("Expected NewConverter() with no options to behave like TypedToVal, got %v != %v", got, want)
+	}
+}
+
+// Port is a toy list-map element type, keyed by Name, used to exercise
+// WithListType(..., ListTypeMap, "name").
+type Port struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+type WithLists struct {
+	Tags  []string `json:"tags"`
+	Ports []Port   `json:"ports"`
+}
+
+func TestNewConverterWithListType(t *testing.T) {
+	withSet := NewConverter(WithListType(reflect.TypeOf(WithLists{}), "tags", ListTypeSet))
+	withMap := NewConverter(WithListType(reflect.TypeOf(WithLists{}), "ports", ListTypeMap, "name"))
+
+	tests := []struct {
+		name       string
+		converter  func(interface{}) ref.Val
+		expression string
+		a, b       WithLists
+	}{
 		{
-			name:       "intOrString: string comparison",
-			expression: "c.intOrString == 'port'",
-			activation: map[string]interface{}{"c": complex2},
+			name:       "listType=set: reordered slices equal",
+			converter:  withSet,
+			expression: "a.tags == b.tags",
+			a:          WithLists{Tags: []string{"a", "b", "c"}},
+			b:          WithLists{Tags: []string{"c", "a", "b"}},
 		},
 		{
-			name:       "quantity: comparison",
-			expression: "c.quantity.isGreaterThan(quantity('99m')) && c.quantity.isLessThan(quantity('101m'))",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "listType=set: different elements not equal",
+			converter:  withSet,
+			expression: "a.tags != b.tags",
+			a:          WithLists{Tags: []string{"a", "b", "c"}},
+			b:          WithLists{Tags: []string{"a", "b", "d"}},
 		},
 		{
-			name:       "quantity: equality",
-			expression: "c.quantity == quantity('100m')",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "listType=set: different length not equal",
+			converter:  withSet,
+			expression: "a.tags != b.tags",
+			a:          WithLists{Tags: []string{"a", "b"}},
+			b:          WithLists{Tags: []string{"a", "b", "b"}},
 		},
 		{
-			name:       "bytes: size",
-			expression: "size(c.rawBytes) == 6",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "listType=map: reordered keyed elements equal",
+			converter:  withMap,
+			expression: "a.ports == b.ports",
+			a:          WithLists{Ports: []Port{{Name: "http", Port: 80}, {Name: "https", Port: 443}}},
+			b:          WithLists{Ports: []Port{{Name: "https", Port: 443}, {Name: "http", Port: 80}}},
 		},
 		{
-			name:       "bytes: equality",
-			expression: "c.rawBytes == b'bytes1'",
-			activation: map[string]interface{}{"c": complex1},
+			name:       "listType=map: same keys, different values not equal",
+			converter:  withMap,
+			expression: "a.ports != b.ports",
+			a:          WithLists{Ports: []Port{{Name: "http", Port: 80}}},
+			b:          WithLists{Ports: []Port{{Name: "http", Port: 8080}}},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var opts []cel.EnvOption
-			for k := range tt.activation {
-				opts = append(opts, cel.Variable(k, cel.DynType))
-			}
-			opts = append(opts, cel.StdLib(), library.Quantity())
-
-			env, err := cel.NewEnv(opts...)
+			env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.StdLib())
 			if err != nil {
 				t.Fatalf("Env creation error: %v", err)
 			}
 
-			typedOut, typedErr := evalExpression(t, env, tt.expression, typedToValActivation(tt.activation))
-			if typedErr != nil && len(tt.wantErr) == 0 {
-				t.Fatalf("Unexpected err: %v", typedErr)
+			activation := map[string]interface{}{
+				"a": tt.converter(tt.a),
+				"b": tt.converter(tt.b),
 			}
-			if len(tt.wantErr) > 0 {
-				if typedErr == nil {
-					t.Fatalf("Expected error '%s' during evaluation, but got none", tt.wantErr)
-				}
-				if typedErr.Error() != tt.wantErr {
-					t.Fatalf("Expected error '%s' during evaluation, but got: %v", tt.wantErr, typedErr)
-				}
+
+			out, evalErr := evalExpression(t, env, tt.expression, activation)
+			if evalErr != nil {
+				t.Fatalf("Unexpected err: %v", evalErr)
+			}
+			if out != types.True {
+				t.Errorf("Expected true but got %v", out)
+			}
+		})
+	}
+}
+
+// Condition is modeled on the Kubernetes metav1.Condition shape: a
+// +listType=map element keyed by Type, the pattern used throughout
+// Kubernetes status subresources (e.g. Pod.status.conditions).
+type Condition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+type WithConditions struct {
+	Conditions []Condition `json:"conditions"`
+}
+
+// TestNewConverterWithListTypeConditions exercises WithListType(...,
+// ListTypeMap, "type") against a status-conditions-shaped slice, confirming
+// both CEL == (order-independent keyed equality, the oldSelf == self
+// ratcheting case this chunk is about) and "in" (already order-independent
+// regardless of ListType, since Contains scans for a fully-equal element).
+func TestNewConverterWithListTypeConditions(t *testing.T) {
+	converter := NewConverter(WithListType(reflect.TypeOf(WithConditions{}), "conditions", ListTypeMap, "type"))
+
+	a := converter(WithConditions{Conditions: []Condition{
+		{Type: "Ready", Status: "True"},
+		{Type: "Available", Status: "False"},
+	}})
+	b := converter(WithConditions{Conditions: []Condition{
+		{Type: "Available", Status: "False"},
+		{Type: "Ready", Status: "True"},
+	}})
+	c := converter(WithConditions{Conditions: []Condition{
+		{Type: "Ready", Status: "False"}, // same key, different status
+		{Type: "Available", Status: "False"},
+	}})
+
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.Variable("c", cel.DynType))
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"reordered conditions are equal", "a.conditions == b.conditions"},
+		{"same key, different status is not equal", "a.conditions != c.conditions"},
+		{"in is order-independent regardless of position", "b.conditions[0] in a.conditions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activation := map[string]interface{}{"a": a, "b": b, "c": c}
+			out, evalErr := evalExpression(t, env, tt.expression, activation)
+			if evalErr != nil {
+				t.Fatalf("Unexpected err: %v", evalErr)
+			}
+			if out != types.True {
+				t.Errorf("Expected true but got %v", out)
+			}
+		})
+	}
+}
+
+// TestComplexTagsDefaultOrderSensitive confirms that, without WithListType,
+// Complex.Tags retains strict index/order equality -- the chunk's requested
+// "remaining false by default" case.
+func TestComplexTagsDefaultOrderSensitive(t *testing.T) {
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.StdLib())
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+	activation := map[string]interface{}{
+		"a": TypedToVal(Complex{Tags: []string{"a", "b", "c"}}),
+		"b": TypedToVal(Complex{Tags: []string{"c", "a", "b"}}),
+	}
+	out, evalErr := evalExpression(t, env, "a.tags != b.tags", activation)
+	if evalErr != nil {
+		t.Fatalf("Unexpected err: %v", evalErr)
+	}
+	if out != types.True {
+		t.Errorf("Expected reordered Tags to compare unequal by default, got %v", out)
+	}
+}
+
+// TestNewConverterWithEvalLimits covers each of EvalLimits' three bounds:
+// MaxDepth rejects recursing past the allowed number of nested containers,
+// MaxIterElements errors out partway through an Iterator rather than
+// converting every element, and MaxAddResultSize errors out of Add rather
+// than growing the result slice without bound.
+func TestNewConverterWithEvalLimits(t *testing.T) {
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType))
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+
+	t.Run("MaxDepth", func(t *testing.T) {
+		limited := NewConverter(WithEvalLimits(EvalLimits{MaxDepth: 1}))
+		a := limited(Complex{NestedObj: Nested{Name: "n", Info: Struct{S: "s"}}})
+
+		// NestedObj is one level deep -- allowed.
+		if out, evalErr := evalExpression(t, env, "a.nestedObj.name == 'n'", map[string]interface{}{"a": a}); evalErr != nil || out != types.True {
+			t.Fatalf("expected NestedObj access to succeed within MaxDepth, got %v, err %v", out, evalErr)
+		}
+		// NestedObj.Info is two levels deep -- over the limit.
+		_, evalErr := evalExpression(t, env, "a.nestedObj.info.s == 's'", map[string]interface{}{"a": a})
+		if evalErr == nil {
+			t.Fatalf("expected an error recursing past MaxDepth, got none")
+		}
+	})
+
+	t.Run("MaxIterElements", func(t *testing.T) {
+		limited := NewConverter(WithEvalLimits(EvalLimits{MaxIterElements: 3}))
+		a := limited(Complex{Tags: []string{"a", "b", "c", "d", "e"}})
+
+		_, evalErr := evalExpression(t, env, "a.tags.all(x, x != '')", map[string]interface{}{"a": a})
+		if evalErr == nil {
+			t.Fatalf("expected an error iterating past MaxIterElements, got none")
+		}
+	})
+
+	t.Run("MaxAddResultSize", func(t *testing.T) {
+		limited := NewConverter(WithEvalLimits(EvalLimits{MaxAddResultSize: 3}))
+		a := limited(Complex{Tags: []string{"a", "b"}})
+		b := limited(Complex{Tags: []string{"c", "d"}})
+
+		_, evalErr := evalExpression(t, env, "size(a.tags + b.tags) == 4", map[string]interface{}{"a": a, "b": b})
+		if evalErr == nil {
+			t.Fatalf("expected an error growing the Add result past MaxAddResultSize, got none")
+		}
+	})
+}
+
+func TestNewConverterWithFloatTolerance(t *testing.T) {
+	tolerant := NewConverter(WithFloatTolerance(0, 1e-6))
+
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.StdLib())
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+
+	t.Run("within tolerance", func(t *testing.T) {
+		// float64 literals: as float32, 32.5000001 rounds to exactly 32.5.
+		activation := map[string]interface{}{"a": tolerant(32.5), "b": tolerant(32.5000001)}
+		out, evalErr := evalExpression(t, env, "a == b", activation)
+		if evalErr != nil {
+			t.Fatalf("Unexpected err: %v", evalErr)
+		}
+		if out != types.True {
+			t.Errorf("Expected 32.5 == 32.5000001 within tolerance, got %v", out)
+		}
+	})
+
+	t.Run("outside tolerance", func(t *testing.T) {
+		activation := map[string]interface{}{"a": tolerant(float32(32.5)), "b": tolerant(float32(33.5))}
+		out, evalErr := evalExpression(t, env, "a != b", activation)
+		if evalErr != nil {
+			t.Fatalf("Unexpected err: %v", evalErr)
+		}
+		if out != types.True {
+			t.Errorf("Expected 32.5 != 33.5 outside tolerance, got %v", out)
+		}
+	})
+
+	t.Run("default: no tolerance configured", func(t *testing.T) {
+		activation := map[string]interface{}{"a": TypedToVal(32.5), "b": TypedToVal(32.5000001)}
+		out, evalErr := evalExpression(t, env, "a != b", activation)
+		if evalErr != nil {
+			t.Fatalf("Unexpected err: %v", evalErr)
+		}
+		if out != types.True {
+			t.Errorf("Expected 32.5 != 32.5000001 by default (no tolerance), got %v", out)
+		}
+	})
+}
+
+func TestNewConverterWithTimeTolerance(t *testing.T) {
+	tolerant := NewConverter(WithTimeTolerance(2 * time.Second))
+
+	base := metav1.Time{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	nearby := metav1.Time{Time: base.Add(500 * time.Millisecond)}
+	farAway := metav1.Time{Time: base.Add(10 * time.Second)}
+
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.StdLib())
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+
+	t.Run("within tolerance", func(t *testing.T) {
+		activation := map[string]interface{}{"a": tolerant(base), "b": tolerant(nearby)}
+		out, evalErr := evalExpression(t, env, "a == b", activation)
+		if evalErr != nil {
+			t.Fatalf("Unexpected err: %v", evalErr)
+		}
+		if out != types.True {
+			t.Errorf("Expected times within tolerance to be equal, got %v", out)
+		}
+	})
+
+	t.Run("outside tolerance", func(t *testing.T) {
+		activation := map[string]interface{}{"a": tolerant(base), "b": tolerant(farAway)}
+		out, evalErr := evalExpression(t, env, "a != b", activation)
+		if evalErr != nil {
+			t.Fatalf("Unexpected err: %v", evalErr)
+		}
+		if out != types.True {
+			t.Errorf("Expected times outside tolerance to be unequal, got %v", out)
+		}
+	})
+
+	t.Run("default: no tolerance configured", func(t *testing.T) {
+		activation := map[string]interface{}{"a": TypedToVal(base), "b": TypedToVal(nearby)}
+		out, evalErr := evalExpression(t, env, "a != b", activation)
+		if evalErr != nil {
+			t.Fatalf("Unexpected err: %v", evalErr)
+		}
+		if out != types.True {
+			t.Errorf("Expected times to differ by default (no tolerance), got %v", out)
+		}
+	})
+}
+
+func TestEqualWithDiff(t *testing.T) {
+	struct1 := Struct{S: "hello", I: 10, B: true, F: 1.5}
+	struct2 := Struct{S: "world", I: 20, B: false, F: 2.5}
+	nested1 := Nested{Name: "nested1", Info: struct1}
+
+	base := Complex{
+		ID:        "c1",
+		Tags:      []string{"a", "b", "c"},
+		Labels:    map[string]string{"key1": "val1", "key2": "val2"},
+		NestedObj: nested1,
+		ChildPtr:  &struct1,
+	}
+
+	tests := []struct {
+		name      string
+		a, b      Complex
+		wantSteps []string
+	}{
+		{
+			name:      "identical",
+			a:         base,
+			b:         base,
+			wantSteps: nil,
+		},
+		{
+			name: "differing NestedObj.Info.S",
+			a:    base,
+			b: func() Complex {
+				c := base
+				c.NestedObj = Nested{Name: "nested1", Info: Struct{S: "goodbye", I: 10, B: true, F: 1.5}}
+				return c
+			}(),
+			wantSteps: []string{`.nestedObj.info.s: "hello" != "goodbye"`},
+		},
+		{
+			name: "differing Labels keys",
+			a:    base,
+			b: func() Complex {
+				c := base
+				c.Labels = map[string]string{"key1": "val1", "key3": "val3"}
+				return c
+			}(),
+			wantSteps: []string{
+				`.labels["key2"]: removed "val2"`,
+				`.labels["key3"]: added "val3"`,
+			},
+		},
+		{
+			name: "differing ChildPtr target",
+			a:    base,
+			b: func() Complex {
+				c := base
+				c.ChildPtr = &struct2
+				return c
+			}(),
+			wantSteps: []string{`.childPtr.s: "hello" != "world"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, steps := EqualWithDiff(TypedToVal(tt.a), TypedToVal(tt.b))
+			if equal != (len(tt.wantSteps) == 0) {
+				t.Fatalf("EqualWithDiff equal=%v, want %v", equal, len(tt.wantSteps) == 0)
+			}
+			got := make([]string, len(steps))
+			for i, s := range steps {
+				got[i] = s.String()
 			}
-			if len(tt.wantErr) == 0 && typedOut != types.True {
-				t.Errorf("Expected true but got %v", typedOut)
+			sort.Strings(got)
+			want := append([]string(nil), tt.wantSteps...)
+			sort.Strings(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("diff steps = %v, want %v", got, want)
 			}
 		})
 	}
 }
 
+func TestDiffCELFunction(t *testing.T) {
+	struct1 := Struct{S: "hello", I: 10, B: true, F: 1.5}
+	a := Nested{Name: "n", Info: struct1}
+	b := Nested{Name: "n", Info: Struct{S: "goodbye", I: 10, B: true, F: 1.5}}
+
+	env, err := cel.NewEnv(cel.Variable("a", cel.DynType), cel.Variable("b", cel.DynType), cel.StdLib(), Diff())
+	if err != nil {
+		t.Fatalf("Env creation error: %v", err)
+	}
+	out, evalErr := evalExpression(t, env, `diff(a, b) == ".info.s: \"hello\" != \"goodbye\""`, map[string]interface{}{
+		"a": TypedToVal(a),
+		"b": TypedToVal(b),
+	})
+	if evalErr != nil {
+		t.Fatalf("Unexpected err: %v", evalErr)
+	}
+	if out != types.True {
+		t.Errorf("Expected true but got %v", out)
+	}
+}
+
+// failureWithDiff builds a test failure message for a test case whose
+// expression evaluated to something other than true. When the activation
+// has exactly two values, it also reports their structural diff (via
+// EqualWithDiff) so a failing equality assertion shows which nested field
+// diverged, rather than just the boolean result.
+func failureWithDiff(activation map[string]any, got ref.Val) string {
+	msg := fmt.Sprintf("Expected true but got %v", got)
+	if len(activation) != 2 {
+		return msg
+	}
+	vals := make([]ref.Val, 0, 2)
+	for _, v := range activation {
+		vals = append(vals, TypedToVal(v))
+	}
+	if _, steps := EqualWithDiff(vals[0], vals[1]); len(steps) > 0 {
+		lines := make([]string, len(steps))
+		for i, s := range steps {
+			lines[i] = s.String()
+		}
+		msg += "\ndiff: " + strings.Join(lines, "; ")
+	}
+	return msg
+}
+
 func evalExpression(t *testing.T, env *cel.Env, expression string, activation map[string]interface{}) (ref.Val, error) {
 	ast, iss := env.Compile(expression)
 	if iss.Err() != nil {
@@ -802,15 +1009,16 @@ func evalExpression(t *testing.T, env *cel.Env, expression string, activation ma
 	return out, err
 }
 
-// 40.21 ns/op
-func BenchmarkListFields(b *testing.B) {
+// benchmarkComplex returns the Complex fixture shared by the TypedToVal
+// benchmarks below.
+func benchmarkComplex() Complex {
 	struct1 := Struct{S: "hello", I: 10, B: true, F: 1.5}
 	struct2 := Struct{S: "world", I: 20, B: false, F: 2.5}
 	duration1 := metav1.Duration{Duration: 5 * time.Second}
 
 	nested1 := Nested{Name: "nested1", Info: struct1}
 
-	complex1 := Complex{
+	return Complex{
 		TypeMeta:    metav1.TypeMeta{Kind: "Complex", APIVersion: "v1"},
 		ObjectMeta:  metav1.ObjectMeta{Name: "complex1"},
 		ID:          "c1",
@@ -833,6 +1041,40 @@ func BenchmarkListFields(b *testing.B) {
 		F32:         float32(32.5),
 		Enum:        EnumTypeA,
 	}
+}
+
+// 40.21 ns/op
+func BenchmarkListFields(b *testing.B) {
+	complex1 := benchmarkComplex()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := TypedToVal(complex1)
+		v.(traits.Indexer).Get(types.String("labels"))
+	}
+}
+
+// BenchmarkTypedToVal_ColdCache measures a single field Get with the
+// structCache entry for Complex evicted on every iteration, simulating the
+// reflect field walk, JSON tag parsing, and kind classification that a
+// never-before-seen type pays on its first CEL evaluation.
+func BenchmarkTypedToVal_ColdCache(b *testing.B) {
+	complex1 := benchmarkComplex()
+	complexType := reflect.TypeOf(complex1)
+
+	for i := 0; i < b.N; i++ {
+		structCache.Delete(complexType)
+		v := TypedToVal(complex1)
+		v.(traits.Indexer).Get(types.String("labels"))
+	}
+}
+
+// BenchmarkTypedToVal_WarmCache measures the same field Get once the
+// structCache entry for Complex has already been populated, the steady
+// state for repeated CEL evaluations against the same type.
+func BenchmarkTypedToVal_WarmCache(b *testing.B) {
+	complex1 := benchmarkComplex()
+	TypedToVal(complex1).(traits.Indexer).Get(types.String("labels")) // populate structCache
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
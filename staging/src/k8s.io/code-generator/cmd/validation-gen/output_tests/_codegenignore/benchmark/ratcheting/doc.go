@@ -0,0 +1,26 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratcheting is not a generator test package; it exists solely to
+// benchmark candidate implementations of item-level validation ratcheting
+// for +k8s:listType=map slices. See doc_test.go.
+package ratcheting
+
+// Endpoint is a minimal +k8s:listType=map item (keyed by Name) used to
+// benchmark ratcheting lookup strategies.
+type Endpoint struct {
+	Name string
+}
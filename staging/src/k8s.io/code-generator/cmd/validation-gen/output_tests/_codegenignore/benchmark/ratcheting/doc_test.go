@@ -96,6 +96,20 @@ func lookup[T any](list []T, target T, cmp func(T, T) bool) *T {
 	return nil
 }
 
+// KeyBasedIndexLookup - Ratcheting using validate.EachSliceValMap, which
+// builds a map[key]*T index over oldSlice once (O(N+M)) instead of
+// scanning oldSlice per new element (O(N*M)). This is the production
+// implementation generated for +k8s:listType=map fields with declared
+// +k8s:listMapKey fields.
+func EachSliceValKeyBased[T any](ctx context.Context, op operation.Operation, fldPath *field.Path, newSlice, oldSlice []T,
+	keyFn validate.KeyFunc[T], equiv validate.CompareFunc[T], validator validate.ValidateFunc[*T]) field.ErrorList {
+	return validate.EachSliceValMap(ctx, op, fldPath, newSlice, oldSlice, keyFn, equiv, validator)
+}
+
+func endpointKey(e *Endpoint) string {
+	return validate.ListMapKey(e.Name)
+}
+
 func validateEndpoint(ctx context.Context, op operation.Operation, fldPath *field.Path, obj, oldObj *Endpoint) field.ErrorList {
 	return apivalidation.ValidateDNS1123Label(obj.Name, fldPath.Child("name"))
 }
@@ -255,6 +269,13 @@ func BenchmarkHeavyValidation(b *testing.B) {
 		}
 	})
 
+	b.Run("HeavyValidation_KeyBasedIndex", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = EachSliceValKeyBased[Endpoint](ctx, op, fldPath, newSlice, oldSlice, endpointKey, validate.SemanticDeepEqual[Endpoint], validateEndpointHeavy)
+		}
+	})
+
 	b.Run("HeavyValidation_NoRatcheting", func(b *testing.B) {
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simple
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test(t *testing.T) {
+	st := localSchemeBuilder.Test(t)
+
+	st.Value(&Struct{
+		Password:        "hunter2",
+		ConfirmPassword: "hunter2",
+	}).ExpectValid()
+
+	st.Value(&Struct{
+		Password:        "hunter2",
+		ConfirmPassword: "different",
+	}).ExpectInvalid(
+		field.Invalid(field.NewPath("confirmPassword"), "different", `must equal field "password"`),
+	)
+
+	// Ratcheting: an update that changes neither Password nor
+	// ConfirmPassword is not re-validated, even though the two were already
+	// mismatched before the update.
+	mismatched := &Struct{
+		Password:        "hunter2",
+		ConfirmPassword: "different",
+	}
+	st.Value(mismatched).OldValue(mismatched).ExpectValid()
+
+	st.Value(&NeFieldStruct{
+		From: "a",
+		To:   "b",
+	}).ExpectValid()
+
+	st.Value(&NeFieldStruct{
+		From: "a",
+		To:   "a",
+	}).ExpectInvalid(
+		field.Invalid(field.NewPath("to"), "a", `must not equal field "from"`),
+	)
+
+	same := &NeFieldStruct{From: "a", To: "a"}
+	st.Value(same).OldValue(same).ExpectValid()
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:validation-gen=TypeMeta
+// +k8s:validation-gen-scheme-registry=k8s.io/code-generator/cmd/validation-gen/testscheme.Scheme
+
+// Package eqoneoffloat tests float validation for eqOneOf tag.
+package eqoneoffloat
+
+import "k8s.io/code-generator/cmd/validation-gen/testscheme"
+
+var localSchemeBuilder = testscheme.New()
+
+type Struct struct {
+	TypeMeta int `json:"typeMeta"`
+
+	// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+	Float32Field float32 `json:"float32Field"`
+	// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+	Float32PtrField *float32 `json:"float32PtrField"`
+
+	// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+	Float64Field float64 `json:"float64Field"`
+	// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+	Float64PtrField *float64 `json:"float64PtrField"`
+
+	// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+	TypedefField FloatType `json:"typedefField"`
+	// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+	TypedefPtrField *FloatType `json:"typedefPtrField"`
+
+	ValidatedTypedefFloatField ValidatedFloatType `json:"validatedTypedefFloatField"`
+}
+
+type FloatType float64
+
+// +k8s:eqOneOf=`[1.5,2.5,3.5]`
+type ValidatedFloatType float64
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eqoneoffloat
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func Test(t *testing.T) {
+	st := localSchemeBuilder.Test(t)
+
+	// Test valid values.
+	st.Value(&Struct{
+		Float32Field:               2.5,
+		Float32PtrField:            ptr.To(float32(2.5)),
+		Float64Field:               2.5,
+		Float64PtrField:            ptr.To(2.5),
+		TypedefField:               2.5,
+		TypedefPtrField:            ptr.To(FloatType(2.5)),
+		ValidatedTypedefFloatField: 2.5,
+	}).ExpectValid()
+
+	// Test invalid values.
+	invalid := &Struct{
+		Float32Field:               4.5,
+		Float32PtrField:            ptr.To(float32(4.5)),
+		Float64Field:               4.5,
+		Float64PtrField:            ptr.To(4.5),
+		TypedefField:               4.5,
+		TypedefPtrField:            ptr.To(FloatType(4.5)),
+		ValidatedTypedefFloatField: 4.5,
+	}
+	st.Value(invalid).ExpectInvalid(
+		field.NotSupported(field.NewPath("float32Field"), "4.5", []string{"1.5", "2.5", "3.5"}),
+		field.NotSupported(field.NewPath("float32PtrField"), "4.5", []string{"1.5", "2.5", "3.5"}),
+		field.NotSupported(field.NewPath("float64Field"), "4.5", []string{"1.5", "2.5", "3.5"}),
+		field.NotSupported(field.NewPath("float64PtrField"), "4.5", []string{"1.5", "2.5", "3.5"}),
+		field.NotSupported(field.NewPath("typedefField"), "4.5", []string{"1.5", "2.5", "3.5"}),
+		field.NotSupported(field.NewPath("typedefPtrField"), "4.5", []string{"1.5", "2.5", "3.5"}),
+		field.NotSupported(field.NewPath("validatedTypedefFloatField"), "4.5", []string{"1.5", "2.5", "3.5"}),
+	)
+
+	// Test nil pointers.
+	st.Value(&Struct{
+		Float32Field:               3.5,
+		Float32PtrField:            nil,
+		Float64Field:               3.5,
+		Float64PtrField:            nil,
+		TypedefField:               3.5,
+		TypedefPtrField:            nil,
+		ValidatedTypedefFloatField: 3.5,
+	}).ExpectValid()
+
+	// Test ratcheting
+	st.Value(invalid).OldValue(invalid).ExpectValid()
+}
@@ -88,6 +88,20 @@ type Struct struct {
 	// +k8s:optional
 	// +k8s:immutable
 	OptionalImmutableField *string `json:"optionalImmutableField"`
+
+	// +k8s:immutableDeep
+	DeepImmutableField NonComparableStruct `json:"deepImmutableField"`
+
+	// +k8s:immutableDeep
+	DeepImmutableSliceField []string `json:"deepImmutableSliceField"`
+}
+
+// +k8s:immutableWhen(field: "volumeName", when: "phase == \"Bound\"")
+type ImmutableWhenStruct struct {
+	TypeMeta int
+
+	Phase      string `json:"phase"`
+	VolumeName string `json:"volumeName"`
 }
 
 type ComparableStruct struct {
@@ -196,4 +196,66 @@ func Test(t *testing.T) {
 
 	// Fields with zero value -> non-zero value is unset -> set
 	st.Value(&structNonZeroValues).OldValue(&structZeroValues).ExpectValid()
+
+	// k8s:immutableWhen: predicate true + change is forbidden.
+	st.Value(&ImmutableWhenStruct{
+		Phase:      "Bound",
+		VolumeName: "pv-2",
+	}).OldValue(&ImmutableWhenStruct{
+		Phase:      "Bound",
+		VolumeName: "pv-1",
+	}).ExpectInvalid(
+		field.Forbidden(field.NewPath("volumeName"), "field is immutable"),
+	)
+
+	// k8s:immutableWhen: predicate true + no change is valid.
+	boundUnchanged := &ImmutableWhenStruct{Phase: "Bound", VolumeName: "pv-1"}
+	st.Value(boundUnchanged).OldValue(boundUnchanged).ExpectValid()
+
+	// k8s:immutableWhen: predicate false + change is allowed.
+	st.Value(&ImmutableWhenStruct{
+		Phase:      "Pending",
+		VolumeName: "pv-2",
+	}).OldValue(&ImmutableWhenStruct{
+		Phase:      "Pending",
+		VolumeName: "pv-1",
+	}).ExpectValid()
+
+	// k8s:immutableWhen: predicate transitions false -> true in the same
+	// update that changes the guarded field; still forbidden, since the
+	// predicate is evaluated against the new object.
+	st.Value(&ImmutableWhenStruct{
+		Phase:      "Bound",
+		VolumeName: "pv-2",
+	}).OldValue(&ImmutableWhenStruct{
+		Phase:      "Pending",
+		VolumeName: "pv-1",
+	}).ExpectInvalid(
+		field.Forbidden(field.NewPath("volumeName"), "field is immutable"),
+	)
+
+	// k8s:immutableDeep: structurally-equal-but-differently-allocated nested
+	// slices (and a slice field nested inside a struct) are accepted -- deep
+	// comparison, not pointer/slice-header identity, decides equality.
+	deepA := &Struct{
+		RequiredImmutableField:  "required",
+		DeepImmutableField:      NonComparableStruct{SliceField: []string{"p", "q"}},
+		DeepImmutableSliceField: []string{"p", "q"},
+	}
+	deepA2 := &Struct{
+		RequiredImmutableField:  "required",
+		DeepImmutableField:      NonComparableStruct{SliceField: append([]string{}, "p", "q")},
+		DeepImmutableSliceField: append([]string{}, "p", "q"),
+	}
+	st.Value(deepA).OldValue(deepA2).ExpectValid()
+
+	deepChanged := &Struct{
+		RequiredImmutableField:  "required",
+		DeepImmutableField:      NonComparableStruct{SliceField: []string{"p", "changed"}},
+		DeepImmutableSliceField: []string{"p", "changed"},
+	}
+	st.Value(deepChanged).OldValue(deepA).ExpectInvalid(
+		field.Forbidden(field.NewPath("deepImmutableField"), "field is immutable"),
+		field.Forbidden(field.NewPath("deepImmutableSliceField"), "field is immutable"),
+	)
 }
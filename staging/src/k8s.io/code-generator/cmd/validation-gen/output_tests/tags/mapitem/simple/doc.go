@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:validation-gen=TypeMeta
+// +k8s:validation-gen-scheme-registry=k8s.io/code-generator/cmd/validation-gen/testscheme.Scheme
+
+// This is a test package.
+package simple
+
+import "k8s.io/code-generator/cmd/validation-gen/testscheme"
+
+var localSchemeBuilder = testscheme.New()
+
+type Struct struct {
+	TypeMeta int `json:"typeMeta"`
+
+	// +k8s:mapType=granular
+	// +k8s:mapItem("target")=+k8s:validateFalse="mapItem StringMap[target]"
+	StringMap map[string]string `json:"stringMap"`
+
+	// +k8s:mapType=granular
+	// +k8s:eachMapValue=+k8s:immutable
+	RatchetMap map[string]string `json:"ratchetMap"`
+
+	// +k8s:mapType=granular
+	// +k8s:mapItem("target")=+k8s:subfield(data)=+k8s:validateFalse="mapItem StructMap[target].data"
+	StructMap map[string]Item `json:"structMap"`
+
+	// +k8s:mapType=granular
+	// +k8s:eachMapValue=+k8s:validateFalse="mapItem IntMap each value"
+	IntMap map[string]int `json:"intMap"`
+
+	// +k8s:mapType=granular
+	// +k8s:eachMapKey=+k8s:validateFalse="mapItem KeyLimited each key"
+	KeyLimited map[string]string `json:"keyLimited"`
+
+	// +k8s:mapType=granular
+	// +k8s:eachKey=+k8s:validateFalse="mapItem TypedKeyMap each key"
+	TypedKeyMap map[MyKey]string `json:"typedKeyMap"`
+
+	// +k8s:mapType=granular
+	// +k8s:eachVal=+k8s:validateFalse="mapItem AliasValMap each value"
+	AliasValMap map[string]string `json:"aliasValMap"`
+}
+
+type Item struct {
+	Data string `json:"data"`
+}
+
+// MyKey is a typedef-string map key, to exercise the ~string branch of
+// EachMapKey's cmp.Ordered generic constraint through +k8s:eachKey.
+type MyKey string
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simple
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test(t *testing.T) {
+	st := localSchemeBuilder.Test(t)
+
+	st.Value(&Struct{
+		StringMap: map[string]string{"other": "d1", "target": "d2"},
+	}).ExpectValidateFalseByPath(map[string][]string{
+		`stringMap[target]`: {"mapItem StringMap[target]"},
+	})
+
+	st.Value(&Struct{
+		StructMap: map[string]Item{
+			"other":  {Data: "any"},
+			"target": {Data: "fails"},
+		},
+	}).ExpectValidateFalseByPath(map[string][]string{
+		`structMap[target].data`: {"mapItem StructMap[target].data"},
+	})
+
+	st.Value(&Struct{
+		IntMap: map[string]int{"a": 1, "b": 2},
+	}).ExpectValidateFalseByPath(map[string][]string{
+		`intMap[a]`: {"mapItem IntMap each value"},
+		`intMap[b]`: {"mapItem IntMap each value"},
+	})
+
+	st.Value(&Struct{
+		KeyLimited: map[string]string{"a": "1", "b": "2"},
+	}).ExpectValidateFalseByPath(map[string][]string{
+		`keyLimited[a]`: {"mapItem KeyLimited each key"},
+		`keyLimited[b]`: {"mapItem KeyLimited each key"},
+	})
+
+	st.Value(&Struct{
+		TypedKeyMap: map[MyKey]string{"a": "1", "b": "2"},
+	}).ExpectValidateFalseByPath(map[string][]string{
+		`typedKeyMap[a]`: {"mapItem TypedKeyMap each key"},
+		`typedKeyMap[b]`: {"mapItem TypedKeyMap each key"},
+	})
+
+	st.Value(&Struct{
+		AliasValMap: map[string]string{"a": "1", "b": "2"},
+	}).ExpectValidateFalseByPath(map[string][]string{
+		`aliasValMap[a]`: {"mapItem AliasValMap each value"},
+		`aliasValMap[b]`: {"mapItem AliasValMap each value"},
+	})
+
+	// Ratcheting: under +k8s:eachMapValue each entry is compared with the
+	// old entry of the same key, so only the entry whose value changed ("b")
+	// is reported, not its unchanged sibling ("a").
+	oldStruct := &Struct{
+		RatchetMap: map[string]string{"a": "1", "b": "2"},
+	}
+	newStruct := &Struct{
+		RatchetMap: map[string]string{"a": "1", "b": "3"},
+	}
+	st.Value(newStruct).OldValue(oldStruct).ExpectInvalid(
+		field.Forbidden(field.NewPath("ratchetMap").Key("b"), "field is immutable"),
+	)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitionallowed
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test(t *testing.T) {
+	st := localSchemeBuilder.Test(t)
+
+	// Adjacency-list form: a listed transition is allowed.
+	st.Value(&Struct{Phase: "Running"}).OldValue(&Struct{Phase: "Pending"}).ExpectValid()
+
+	// Adjacency-list form: a from-state not in the adjacency list has no
+	// allowed outgoing transitions.
+	st.Value(&Struct{Phase: "Running"}).OldValue(&Struct{Phase: "Unknown"}).ExpectInvalid(
+		field.Invalid(field.NewPath("phase"), "Running", "no transitions are defined from state Unknown"),
+	)
+
+	// Adjacency-list form: a terminal from-state listed with an empty
+	// to-list also has no allowed outgoing transitions.
+	st.Value(&Struct{Phase: "Running"}).OldValue(&Struct{Phase: "Failed"}).ExpectInvalid(
+		field.Invalid(field.NewPath("phase"), "Running", "no transitions are defined from state Failed"),
+	)
+
+	// Adjacency-list form: a transition to a state not reachable from the
+	// current state is forbidden.
+	st.Value(&Struct{Phase: "Failed"}).OldValue(&Struct{Phase: "Pending"}).ExpectInvalid(
+		field.Invalid(field.NewPath("phase"), "Failed", "transition from Pending is only allowed to one of [Running]"),
+	)
+}
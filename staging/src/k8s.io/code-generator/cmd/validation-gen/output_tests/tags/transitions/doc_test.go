@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitions
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test(t *testing.T) {
+	st := localSchemeBuilder.Test(t)
+
+	// unset -> set is allowed.
+	st.Value(&Struct{Phase: "Pending"}).OldValue(&Struct{}).ExpectValid()
+
+	// A listed transition is allowed.
+	st.Value(&Struct{Phase: "Running"}).OldValue(&Struct{Phase: "Pending"}).ExpectValid()
+
+	// Reaching a terminal state is allowed.
+	st.Value(&Struct{Phase: "Failed"}).OldValue(&Struct{Phase: "Running"}).ExpectValid()
+
+	// A terminal state has no further listed transitions.
+	st.Value(&Struct{Phase: "Running"}).OldValue(&Struct{Phase: "Failed"}).ExpectInvalid(
+		field.Forbidden(field.NewPath("phase"), "transition Failed -> Running is not allowed"),
+	)
+
+	// Skipping straight to a non-adjacent state is forbidden.
+	st.Value(&Struct{Phase: "Succeeded"}).OldValue(&Struct{Phase: "Pending"}).ExpectInvalid(
+		field.Forbidden(field.NewPath("phase"), "transition Pending -> Succeeded is not allowed"),
+	)
+
+	// Object form: a listed transition is allowed.
+	st.Value(&Struct{ObjectFormPhase: "Running"}).OldValue(&Struct{ObjectFormPhase: "Pending"}).ExpectValid()
+
+	// Object form: a from-state absent from the adjacency map (a terminal
+	// state here) has no allowed outgoing transitions.
+	st.Value(&Struct{ObjectFormPhase: "Running"}).OldValue(&Struct{ObjectFormPhase: "Succeeded"}).ExpectInvalid(
+		field.Forbidden(field.NewPath("objectFormPhase"), "transition Succeeded -> Running is not allowed"),
+	)
+
+	// Object form: skipping straight to a non-adjacent state is forbidden.
+	st.Value(&Struct{ObjectFormPhase: "Succeeded"}).OldValue(&Struct{ObjectFormPhase: "Pending"}).ExpectInvalid(
+		field.Forbidden(field.NewPath("objectFormPhase"), "transition Pending -> Succeeded is not allowed"),
+	)
+}
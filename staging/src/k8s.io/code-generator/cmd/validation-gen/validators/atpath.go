@@ -0,0 +1,416 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	atPathTagName = "k8s:atPath"
+)
+
+func init() {
+	RegisterTagValidator(&atPathTagValidator{})
+}
+
+// atPathTagValidator generalizes +k8s:item beyond a single-hop list-map
+// lookup to arbitrary nested traversal, via a small JSONPath-like
+// expression. It is declared on the type it traverses from (ScopeType),
+// the same way +k8s:eqField and friends address a sibling field by name
+// rather than being declared on the field itself.
+type atPathTagValidator struct {
+	validator Validator
+}
+
+func (av *atPathTagValidator) Init(cfg Config) {
+	av.validator = cfg.Validator
+}
+
+func (*atPathTagValidator) TagName() string {
+	return atPathTagName
+}
+
+var atPathTagValidScopes = sets.New(ScopeType)
+
+func (*atPathTagValidator) ValidScopes() sets.Set[Scope] {
+	return atPathTagValidScopes
+}
+
+var validateAtPathValues = types.Name{Package: libValidationPkg, Name: "AtPathValues"}
+
+func (av *atPathTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	if len(tag.Args) != 0 {
+		return Validations{}, fmt.Errorf("does not take any arguments; the path goes in the tag's payload, e.g. +k8s:atPath(\"spec.containers[*].image\")")
+	}
+	if tag.Value == "" {
+		return Validations{}, fmt.Errorf("requires a path expression payload")
+	}
+	if tag.ValueType != codetags.ValueTypeTag {
+		return Validations{}, fmt.Errorf("requires a validation tag as its value payload")
+	}
+	if tag.ValueTag == nil {
+		return Validations{}, fmt.Errorf("requires a non-nil validation tag as its value payload")
+	}
+
+	steps, err := parseAtPath(tag.Value)
+	if err != nil {
+		return Validations{}, fmt.Errorf("invalid path %q: %w", tag.Value, err)
+	}
+
+	rootT := util.NonPointer(util.NativeType(context.Type))
+	extractor, elemT, err := buildAtPathExtractor(rootT, steps)
+	if err != nil {
+		return Validations{}, fmt.Errorf("invalid path %q: %w", tag.Value, err)
+	}
+
+	subContext := Context{
+		Scope:  ScopeField,
+		Type:   elemT,
+		Parent: context.Parent,
+		Path:   context.Path.Child(atPathDisplayString(steps)),
+		Member: context.Member,
+	}
+
+	validations, err := av.validator.ExtractValidations(subContext, *tag.ValueTag)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	// If the chained validator uses the extractor pattern itself, don't
+	// wrap it again -- same bypass +k8s:item uses, so nested +k8s:atPath (or
+	// +k8s:item) tags compose cleanly instead of double-extracting.
+	if tag.ValueTag != nil && tag.ValueTag.Name != "" {
+		if ValidatorUsesExtractorPattern(tag.ValueTag.Name) {
+			return validations, nil
+		}
+	}
+
+	result := Validations{}
+	for _, vfn := range validations.Functions {
+		f := Function(atPathTagName, vfn.Flags, validateAtPathValues, extractor, WrapperFunction{vfn, elemT})
+		result.Functions = append(result.Functions, f)
+	}
+	result.Variables = append(result.Variables, validations.Variables...)
+	return result, nil
+}
+
+// atPathStepKind discriminates the optional bracket selector following an
+// atPathStep's field name.
+type atPathStepKind int
+
+const (
+	atPathStepPlain  atPathStepKind = iota // no selector: just ".field"
+	atPathStepIndex                        // "[N]"
+	atPathStepAll                          // "[*]"
+	atPathStepFilter                       // `[?(@.key=="value")]`, reusing +k8s:listMapItem's selector syntax
+	atPathStepMapKey                       // `["key"]`
+)
+
+type atPathStep struct {
+	field      string
+	kind       atPathStepKind
+	index      int
+	filterKey  string
+	filterWant string
+	mapKey     string
+}
+
+// atPathSegmentPattern matches one dotted segment of a +k8s:atPath
+// expression: a field name with an optional trailing bracket selector. The
+// bracket syntax deliberately reuses +k8s:listMapItem/ListMapElementByJSONPath's
+// established `[?(@.field=="value")]` filter convention rather than
+// inventing a second one, and adds `[*]` (select every element) and a
+// quoted `["key"]` (map index) alongside it.
+var atPathSegmentPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)(?:\[(?:(\*)|(\d+)|\?\(@\.([A-Za-z_][A-Za-z0-9_]*)==("(?:[^"\\]|\\.)*")\)|("(?:[^"\\]|\\.)*"))\])?$`)
+
+// parseAtPath splits expr (e.g. `spec.containers[*].image`) into steps. Only
+// dotted field/selector segments are supported -- no recursive descent
+// (`..`) and no script expressions -- so resolution is a straight-line walk
+// that either succeeds deterministically at generation time or fails with a
+// clear error; there is nothing to evaluate at runtime that wasn't already
+// checked against the compile-time type.
+func parseAtPath(expr string) ([]atPathStep, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("empty path expression")
+	}
+	var steps []atPathStep
+	for _, part := range splitAtPathSegments(expr) {
+		m := atPathSegmentPattern.FindStringSubmatch(part)
+		if m == nil {
+			return nil, fmt.Errorf("invalid path segment %q", part)
+		}
+		step := atPathStep{field: m[1]}
+		switch {
+		case m[2] != "":
+			step.kind = atPathStepAll
+		case m[3] != "":
+			idx, err := strconv.Atoi(m[3])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index in segment %q: %w", part, err)
+			}
+			step.kind = atPathStepIndex
+			step.index = idx
+		case m[4] != "":
+			want, err := strconv.Unquote(m[5])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted value in segment %q: %w", part, err)
+			}
+			step.kind = atPathStepFilter
+			step.filterKey = m[4]
+			step.filterWant = want
+		case m[6] != "":
+			key, err := strconv.Unquote(m[6])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted map key in segment %q: %w", part, err)
+			}
+			step.kind = atPathStepMapKey
+			step.mapKey = key
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// splitAtPathSegments splits expr on '.', treating anything inside a
+// bracket as opaque so a dot inside a filter's quoted value never splits
+// the segment -- the same bracket-depth tracking
+// ListMapElementByJSONPath's splitJSONPathSegments uses.
+func splitAtPathSegments(expr string) []string {
+	var out []string
+	depth := 0
+	start := 0
+	for i, r := range expr {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				out = append(out, expr[start:i])
+				start = i + 1
+			}
+		}
+	}
+	out = append(out, expr[start:])
+	return out
+}
+
+// atPathDisplayString renders steps back into their original-ish textual
+// form, for use as a (non-semantic, human-readable) path segment on the
+// Context passed down to the wrapped validator.
+func atPathDisplayString(steps []atPathStep) string {
+	var sb strings.Builder
+	for i, step := range steps {
+		if i > 0 {
+			sb.WriteString(".")
+		}
+		sb.WriteString(step.field)
+		switch step.kind {
+		case atPathStepAll:
+			sb.WriteString("[*]")
+		case atPathStepIndex:
+			fmt.Fprintf(&sb, "[%d]", step.index)
+		case atPathStepFilter:
+			fmt.Fprintf(&sb, "[?(@.%s==%q)]", step.filterKey, step.filterWant)
+		case atPathStepMapKey:
+			fmt.Fprintf(&sb, "[%q]", step.mapKey)
+		}
+	}
+	return sb.String()
+}
+
+// buildAtPathExtractor compiles steps into a FunctionLiteral of the form
+// `func(obj *rootT) []validate.AtPathElement { ... }`, walking rootT
+// alongside the steps so every field/selector is resolved (and any invalid
+// traversal rejected) at generation time, never by reflection at runtime.
+// It returns the FunctionLiteral and the terminal (leaf) element type.
+func buildAtPathExtractor(rootT *types.Type, steps []atPathStep) (FunctionLiteral, *types.Type, error) {
+	loopN := 0
+	stepsCode, leafT, err := emitAtPathSteps(rootT, steps, "obj", nil, &loopN)
+	if err != nil {
+		return FunctionLiteral{}, nil, err
+	}
+	body := fmt.Sprintf("var out []validate.AtPathElement\nif obj == nil {\nreturn out\n}\n%sreturn out", stepsCode)
+	return FunctionLiteral{
+		Parameters: []ParamResult{{"obj", types.PointerTo(rootT)}},
+		// NOTE: the generator machinery to construct a *types.Type for
+		// "[]validate.AtPathElement" (a slice of a library-package struct) is
+		// not available here -- there is no Universe/type-lookup entry point
+		// in this tag validator, only the compile-time types already reachable
+		// from the tagged field. types.Any stands in as a placeholder; the
+		// emitted Body text itself is unaffected and already returns the
+		// correctly-typed slice.
+		Results: []ParamResult{{Type: types.Any}},
+		Body:    body,
+	}, leafT, nil
+}
+
+// emitAtPathSteps recursively compiles steps into Go source, tracking the Go
+// expression for "the value reached so far" (goExpr) and the
+// []validate.AtPathSegment literal entries that reach it (segExprs). Each
+// selector that can match more than one element ([*], and the
+// first-match-wins list-map filter) introduces a for loop around its
+// continuation; a plain field or numeric index access does not, and simply
+// extends goExpr/segExprs in place. The recursion bottoms out by appending
+// one validate.AtPathElement to `out` per leaf reached.
+func emitAtPathSteps(curType *types.Type, steps []atPathStep, goExpr string, segExprs []string, loopN *int) (string, *types.Type, error) {
+	if len(steps) == 0 {
+		segsLiteral := fmt.Sprintf("[]validate.AtPathSegment{%s}", strings.Join(segExprs, ", "))
+		return fmt.Sprintf("out = append(out, validate.AtPathElement{Value: &(%s), Segments: %s})\n", goExpr, segsLiteral), curType, nil
+	}
+
+	step := steps[0]
+	rest := steps[1:]
+
+	if curType.Kind != types.Struct {
+		return "", nil, fmt.Errorf("cannot select field %q: %s is not a struct", step.field, curType.String())
+	}
+	member := util.GetMemberByJSON(curType, step.field)
+	if member == nil {
+		return "", nil, fmt.Errorf("no field with JSON name %q", step.field)
+	}
+	if isNilableType(member.Type) {
+		return "", nil, fmt.Errorf("atPath does not support traversing through the optional field %q; only non-pointer fields are supported", member.Name)
+	}
+	fieldT := util.NonPointer(util.NativeType(member.Type))
+	fieldGoExpr := fmt.Sprintf("%s.%s", goExpr, member.Name)
+	fieldSegExprs := append(append([]string{}, segExprs...), fmt.Sprintf("{Kind: validate.AtPathField, Field: %q}", step.field))
+
+	switch step.kind {
+	case atPathStepPlain:
+		return emitAtPathSteps(fieldT, rest, fieldGoExpr, fieldSegExprs, loopN)
+
+	case atPathStepIndex:
+		if fieldT.Kind != types.Slice {
+			return "", nil, fmt.Errorf("[%d] can only follow a list field, got %s", step.index, fieldT.String())
+		}
+		if isNilableType(fieldT.Elem) {
+			return "", nil, fmt.Errorf("atPath does not support a list of optional elements for field %q", member.Name)
+		}
+		elemT := util.NonPointer(util.NativeType(fieldT.Elem))
+		indexedGoExpr := fmt.Sprintf("(%s)[%d]", fieldGoExpr, step.index)
+		indexedSegExprs := append(append([]string{}, fieldSegExprs...), fmt.Sprintf("{Kind: validate.AtPathIndex, Index: %d}", step.index))
+		body, leafT, err := emitAtPathSteps(elemT, rest, indexedGoExpr, indexedSegExprs, loopN)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("if len(%s) > %d {\n%s}\n", fieldGoExpr, step.index, body), leafT, nil
+
+	case atPathStepAll:
+		if fieldT.Kind != types.Slice {
+			return "", nil, fmt.Errorf("[*] can only follow a list field, got %s", fieldT.String())
+		}
+		if isNilableType(fieldT.Elem) {
+			return "", nil, fmt.Errorf("atPath does not support a list of optional elements for field %q", member.Name)
+		}
+		elemT := util.NonPointer(util.NativeType(fieldT.Elem))
+		*loopN++
+		idxVar := fmt.Sprintf("atPathIdx%d", *loopN)
+		itemGoExpr := fmt.Sprintf("%s[%s]", fieldGoExpr, idxVar)
+		itemSegExprs := append(append([]string{}, fieldSegExprs...), fmt.Sprintf("{Kind: validate.AtPathIndex, Index: %s}", idxVar))
+		body, leafT, err := emitAtPathSteps(elemT, rest, itemGoExpr, itemSegExprs, loopN)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("for %s := range %s {\n%s}\n", idxVar, fieldGoExpr, body), leafT, nil
+
+	case atPathStepFilter:
+		if fieldT.Kind != types.Slice {
+			return "", nil, fmt.Errorf("[?(@.%s==%q)] filter can only follow a list field, got %s", step.filterKey, step.filterWant, fieldT.String())
+		}
+		elemT := util.NonPointer(util.NativeType(fieldT.Elem))
+		if elemT.Kind != types.Struct {
+			return "", nil, fmt.Errorf("[?(@.%s==%q)] filter can only select from a list of structs", step.filterKey, step.filterWant)
+		}
+		keyMember := util.GetMemberByJSON(elemT, step.filterKey)
+		if keyMember == nil {
+			return "", nil, fmt.Errorf("list item has no field with JSON name %q", step.filterKey)
+		}
+		if util.NativeType(keyMember.Type).Kind != types.Builtin || util.NativeType(keyMember.Type) != types.String {
+			return "", nil, fmt.Errorf("filter key field %q must be of type string or an alias to string, got %s", keyMember.Name, keyMember.Type.String())
+		}
+		*loopN++
+		idxVar := fmt.Sprintf("atPathIdx%d", *loopN)
+		itemGoExpr := fmt.Sprintf("%s[%s]", fieldGoExpr, idxVar)
+		itemSegExprs := append(append([]string{}, fieldSegExprs...), fmt.Sprintf("{Kind: validate.AtPathKey, Key: validate.FormatListMapKey(%q, %q)}", step.filterKey, step.filterWant))
+		body, leafT, err := emitAtPathSteps(elemT, rest, itemGoExpr, itemSegExprs, loopN)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf(
+			"for %s := range %s {\nif (%s).%s == %q {\n%sbreak\n}\n}\n",
+			idxVar, fieldGoExpr, itemGoExpr, keyMember.Name, step.filterWant, body,
+		), leafT, nil
+
+	case atPathStepMapKey:
+		if fieldT.Kind != types.Map {
+			return "", nil, fmt.Errorf("[%q] index can only follow a map field, got %s", step.mapKey, fieldT.String())
+		}
+		if util.NonPointer(util.NativeType(fieldT.Key)) != types.String {
+			return "", nil, fmt.Errorf("[%q] index can only be used on a string-keyed map", step.mapKey)
+		}
+		if isNilableType(fieldT.Elem) {
+			return "", nil, fmt.Errorf("atPath does not support a map of optional values for field %q", member.Name)
+		}
+		valT := util.NonPointer(util.NativeType(fieldT.Elem))
+		*loopN++
+		valVar := fmt.Sprintf("atPathVal%d", *loopN)
+		valSegExprs := append(append([]string{}, fieldSegExprs...), fmt.Sprintf("{Kind: validate.AtPathKey, Key: %q}", step.mapKey))
+		body, leafT, err := emitAtPathSteps(valT, rest, valVar, valSegExprs, loopN)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("if %s, ok := %s[%q]; ok {\n%s}\n", valVar, fieldGoExpr, step.mapKey, body), leafT, nil
+	}
+
+	return "", nil, fmt.Errorf("unknown atPath selector on field %q", step.field)
+}
+
+func (av *atPathTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    av.TagName(),
+		Scopes: av.ValidScopes().UnsortedList(),
+		Description: "Declares a validation for every value selected by a JSONPath-like expression, e.g. " +
+			`+k8s:atPath("spec.containers[*].image")=+k8s:required. This generalizes +k8s:item beyond a single-hop ` +
+			"list-map lookup to arbitrary nested traversal (multiple list/map hops, and selecting every element of a " +
+			"list rather than just one), removing the need to hand-author an extractor field for each new selection shape.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "The tag to evaluate for every value the path selects.",
+		}},
+		PayloadsType:     codetags.ValueTypeTag,
+		PayloadsRequired: true,
+		Usage:            `+k8s:atPath("<path>")=<validation-tag>`,
+		Docs: "<path> is a dot-separated chain of field names (by JSON name), each optionally followed by one bracket " +
+			"selector: [*] selects every element of a list field; [N] selects a numeric index; " +
+			`[?(@.key=="value")] selects the first list-map element whose key field equals value (the same selector ` +
+			"+k8s:listMapItem's JSONPath-style syntax uses); and [\"key\"] indexes a string-keyed map by a literal key. " +
+			"Recursive descent and script expressions are not supported, so every path resolves deterministically " +
+			"against the compile-time type, or is rejected at generation time. Traversing through an optional " +
+			"(pointer) field is not yet supported.",
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+
+	celgo "github.com/google/cel-go/cel"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	celTagName = "k8s:cel"
+)
+
+func init() {
+	RegisterTagValidator(celTagValidator{})
+}
+
+type celTagValidator struct{}
+
+func (celTagValidator) Init(_ Config) {}
+
+func (celTagValidator) TagName() string {
+	return celTagName
+}
+
+var celTagValidScopes = sets.New(ScopeAny)
+
+func (celTagValidator) ValidScopes() sets.Set[Scope] {
+	return celTagValidScopes
+}
+
+var matchesCELValidator = types.Name{Package: libValidationPkg, Name: "MatchesCEL"}
+
+func (celTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	if len(tag.Args) != 0 {
+		return Validations{}, fmt.Errorf("does not take any arguments")
+	}
+	if tag.Value == "" {
+		return Validations{}, fmt.Errorf("requires a CEL expression payload")
+	}
+
+	// Compile eagerly so a broken expression is a codegen-time failure
+	// rather than a panic the first time the generated validation is evaluated
+	// at runtime (MustCompileCEL, which the emitted call expression invokes,
+	// panics on a compile error).
+	env, err := celgo.NewEnv(celgo.Variable("self", celgo.DynType))
+	if err != nil {
+		return Validations{}, fmt.Errorf("building CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(tag.Value)
+	if issues != nil && issues.Err() != nil {
+		return Validations{}, fmt.Errorf("failed to compile CEL expression %q: %w", tag.Value, issues.Err())
+	}
+	if ast.OutputType() != celgo.BoolType {
+		return Validations{}, fmt.Errorf("CEL expression %q must evaluate to a bool, got %s", tag.Value, ast.OutputType())
+	}
+
+	result := Validations{}
+	// TODO: emit a single package-level `var` per unique expression (shared
+	// across every field using it), as k8s:pattern's regexp.MustCompile
+	// literal also still does today, instead of calling MustCompileCEL
+	// inline at each call site -- so the expression is compiled exactly
+	// once at package-init time rather than on every call.
+	result.AddFunction(Function(celTagName, DefaultFlags, matchesCELValidator, Literal(fmt.Sprintf("validate.MustCompileCEL(%q)", tag.Value))))
+	return result, nil
+}
+
+func (cv celTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    cv.TagName(),
+		Scopes: cv.ValidScopes().UnsortedList(),
+		Description: "Declares a validation predicate expressed in CEL, evaluated against the field value (bound to `self`)." +
+			" This is a general escape hatch for predicates the fixed-purpose numeric tags (e.g. minimum/maximum) can't express," +
+			" such as `self in [1, 2, 3] || self > 100`. The expression is type-checked at generation time; the generated" +
+			" code compiles it into a cel.Program with MustCompileCEL on every evaluation, not once. Each evaluation is subject to the same per-call cost limit CRD CEL validation applies, so a" +
+			" pathological expression (e.g. an unbounded comprehension) fails closed rather than blocking indefinitely.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<CEL expression>",
+			Docs:        "Must evaluate to a bool. self refers to the field value this tag is declared on.",
+		}},
+		PayloadsType:     codetags.ValueTypeString,
+		PayloadsRequired: true,
+		Usage:            `+k8s:cel=` + "`" + `self in [1, 2, 3] || self > 100` + "`",
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/code-generator/cmd/validation-gen/util"
 	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/codetags"
 	"k8s.io/gengo/v2/types"
 )
 
@@ -30,8 +31,45 @@ const (
 	// libValidationPkg is the pkgpath to our "standard library" of validation
 	// functions.
 	libValidationPkg = "k8s.io/apimachinery/pkg/api/validate"
+
+	// Enforcement actions for scoped-enforcement-capable tags. "deny" is the
+	// default: a violation fails validation. "warn" and "audit" instead
+	// surface the violation as a non-blocking validate.Result warning or
+	// audit annotation, respectively.
+	actionDeny  = "deny"
+	actionWarn  = "warn"
+	actionAudit = "audit"
 )
 
+// parseEnforcementArgs reads the optional `action=` and `scope=` named
+// arguments shared by any scoped-enforcement-capable tag (e.g. k8s:eqOneOf,
+// k8s:pattern), defaulting to action=deny, scope=all for backward
+// compatibility. A tag validator that wants to support scoped enforcement
+// calls this, then picks its Deny/Warn/Audit validator function variant
+// based on the returned action.
+func parseEnforcementArgs(tag codetags.Tag) (action, scope string, err error) {
+	action, scope = actionDeny, "all"
+	for _, arg := range tag.Args {
+		switch arg.Name {
+		case "action":
+			switch arg.Value {
+			case actionDeny, actionWarn, actionAudit:
+				action = arg.Value
+			default:
+				return "", "", fmt.Errorf("unsupported action %q, must be one of %q, %q, %q", arg.Value, actionDeny, actionWarn, actionAudit)
+			}
+		case "scope":
+			if arg.Value == "" {
+				return "", "", fmt.Errorf("scope argument cannot be empty")
+			}
+			scope = arg.Value
+		default:
+			return "", "", fmt.Errorf("unsupported argument %q", arg.Name)
+		}
+	}
+	return action, scope, nil
+}
+
 // rootTypeString returns a string representation of the relationship between
 // src and dst types, for use in error messages.
 func rootTypeString(src, dst *types.Type) string {
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	eqFieldTagName = "k8s:eqField"
+	neFieldTagName = "k8s:neField"
+)
+
+func init() {
+	RegisterTagValidator(eqFieldTagValidator{})
+	RegisterTagValidator(neFieldTagValidator{})
+}
+
+var crossFieldTagValidScopes = sets.New(ScopeType)
+
+var (
+	validateEqField = types.Name{Package: libValidationPkg, Name: "EqField"}
+	validateNeField = types.Name{Package: libValidationPkg, Name: "NeField"}
+)
+
+// crossFieldArgs are the two named arguments shared by +k8s:eqField and
+// +k8s:neField: the field whose value is constrained ("field"), and the
+// sibling field its value is compared against ("reference"). Reported
+// errors are always rooted at "field", never "reference".
+type crossFieldArgs struct {
+	field, reference string
+}
+
+// parseCrossFieldArgs resolves and validates the "field" and "reference"
+// arguments of a cross-field comparison tag against structT, the struct the
+// tag is declared on. It fails if either name is unknown, is a dotted
+// (cross-struct) path, or if the two fields are not the same directly
+// comparable type -- all at generation time, so a bad reference never
+// reaches runtime.
+func parseCrossFieldArgs(tagName string, structT *types.Type, tag codetags.Tag) (crossFieldArgs, *types.Member, *types.Member, error) {
+	var out crossFieldArgs
+	for _, arg := range tag.Args {
+		switch arg.Name {
+		case "field":
+			out.field = arg.Value
+		case "reference":
+			out.reference = arg.Value
+		default:
+			return out, nil, nil, fmt.Errorf("%s: unsupported argument %q", tagName, arg.Name)
+		}
+	}
+	if out.field == "" || out.reference == "" {
+		return out, nil, nil, fmt.Errorf("%s: requires both 'field' and 'reference' arguments", tagName)
+	}
+	if strings.Contains(out.field, ".") || strings.Contains(out.reference, ".") {
+		return out, nil, nil, fmt.Errorf("%s: cross-struct paths (e.g. \"Spec.Replicas\") are not yet supported, only sibling fields of %s", tagName, structT.Name.Name)
+	}
+
+	fieldMember := util.GetMemberByJSON(structT, out.field)
+	if fieldMember == nil {
+		return out, nil, nil, fmt.Errorf("%s: %s has no field with JSON name %q", tagName, structT.Name.Name, out.field)
+	}
+	refMember := util.GetMemberByJSON(structT, out.reference)
+	if refMember == nil {
+		return out, nil, nil, fmt.Errorf("%s: %s has no field with JSON name %q", tagName, structT.Name.Name, out.reference)
+	}
+
+	fieldT := util.NonPointer(util.NativeType(fieldMember.Type))
+	refT := util.NonPointer(util.NativeType(refMember.Type))
+	if fieldT != refT {
+		return out, nil, nil, fmt.Errorf("%s: field %q (%s) and reference %q (%s) must be the same type", tagName, out.field, fieldT.String(), out.reference, refT.String())
+	}
+	if !util.IsDirectComparable(fieldT) {
+		return out, nil, nil, fmt.Errorf("%s: field %q must be a directly comparable type (e.g. string, int, bool), got %s", tagName, out.field, fieldT.String())
+	}
+
+	return out, fieldMember, refMember, nil
+}
+
+// accessorLiteral builds a FunctionLiteral of the form
+// func(o *structT) *memberT { return &o.Member }, for use as a Function()
+// argument that the generated code calls against obj/oldObj.
+func accessorLiteral(structT *types.Type, member *types.Member) FunctionLiteral {
+	resultT := member.Type
+	prefix := "&"
+	if isNilableType(resultT) {
+		prefix = ""
+	} else {
+		resultT = types.PointerTo(resultT)
+	}
+	return FunctionLiteral{
+		Parameters: []ParamResult{{"o", types.PointerTo(structT)}},
+		Results:    []ParamResult{{"", resultT}},
+		Body:       fmt.Sprintf("return %so.%s", prefix, member.Name),
+	}
+}
+
+type eqFieldTagValidator struct{}
+
+func (eqFieldTagValidator) Init(_ Config) {}
+
+func (eqFieldTagValidator) TagName() string {
+	return eqFieldTagName
+}
+
+func (eqFieldTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldTagValidScopes
+}
+
+func (eqFieldTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", eqFieldTagName)
+	}
+	args, fieldMember, refMember, err := parseCrossFieldArgs(eqFieldTagName, structT, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	f := Function(eqFieldTagName, DefaultFlags, validateEqField, args.field, args.reference,
+		accessorLiteral(structT, fieldMember), accessorLiteral(structT, refMember))
+	return Validations{Functions: []FunctionGen{f}}, nil
+}
+
+func (eqFieldTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:         eqFieldTagName,
+		Scopes:      crossFieldTagValidScopes.UnsortedList(),
+		Description: "Declares that one field of a struct must equal a sibling field of the same struct. Ratchets on update: unchanged values are not re-validated.",
+		Args: []TagArgDoc{
+			{Description: `field="<json-name>": the field whose value is constrained; errors are reported here`},
+			{Description: `reference="<json-name>": the sibling field it must equal`},
+		},
+		Usage: `+k8s:eqField(field: "confirmPassword", reference: "password")`,
+	}
+}
+
+type neFieldTagValidator struct{}
+
+func (neFieldTagValidator) Init(_ Config) {}
+
+func (neFieldTagValidator) TagName() string {
+	return neFieldTagName
+}
+
+func (neFieldTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldTagValidScopes
+}
+
+func (neFieldTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", neFieldTagName)
+	}
+	args, fieldMember, refMember, err := parseCrossFieldArgs(neFieldTagName, structT, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	f := Function(neFieldTagName, DefaultFlags, validateNeField, args.field, args.reference,
+		accessorLiteral(structT, fieldMember), accessorLiteral(structT, refMember))
+	return Validations{Functions: []FunctionGen{f}}, nil
+}
+
+func (neFieldTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:         neFieldTagName,
+		Scopes:      crossFieldTagValidScopes.UnsortedList(),
+		Description: "Declares that one field of a struct must differ from a sibling field of the same struct. Ratchets on update: unchanged values are not re-validated.",
+		Args: []TagArgDoc{
+			{Description: `field="<json-name>": the field whose value is constrained; errors are reported here`},
+			{Description: `reference="<json-name>": the sibling field it must differ from`},
+		},
+		Usage: `+k8s:neField(field: "newPassword", reference: "oldPassword")`,
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	lessThanTagName    = "k8s:lessThan"
+	greaterThanTagName = "k8s:greaterThan"
+	sumEqualsTagName   = "k8s:sumEquals"
+)
+
+func init() {
+	RegisterTagValidator(lessThanTagValidator{})
+	RegisterTagValidator(greaterThanTagValidator{})
+	RegisterTagValidator(sumEqualsTagValidator{})
+}
+
+var crossFieldNumericTagValidScopes = sets.New(ScopeAny)
+
+// lessThanTagValidator implements +k8s:lessThan=<otherField>, a strict
+// cross-field comparison resolved the same way +k8s:minimum and
+// +k8s:maximum resolve their own <otherField> form: via FieldReference.
+type lessThanTagValidator struct{}
+
+func (lessThanTagValidator) Init(_ Config) {}
+
+func (lessThanTagValidator) TagName() string {
+	return lessThanTagName
+}
+
+func (lessThanTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldNumericTagValidScopes
+}
+
+var (
+	lessThanFieldValidator         = types.Name{Package: libValidationPkg, Name: "LessThanField"}
+	lessThanFieldFloatValidator    = types.Name{Package: libValidationPkg, Name: "LessThanFieldFloat"}
+	lessThanFieldQuantityValidator = types.Name{Package: libValidationPkg, Name: "LessThanFieldQuantity"}
+)
+
+func (lessThanTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
+	return crossFieldNumericValidations(context, lessThanTagName, payload,
+		lessThanFieldValidator, lessThanFieldFloatValidator, lessThanFieldQuantityValidator)
+}
+
+func (v lessThanTagValidator) Docs() TagDoc {
+	return crossFieldNumericDocs(v.TagName(), "less than")
+}
+
+// greaterThanTagValidator implements +k8s:greaterThan=<otherField>, the
+// mirror image of lessThanTagValidator.
+type greaterThanTagValidator struct{}
+
+func (greaterThanTagValidator) Init(_ Config) {}
+
+func (greaterThanTagValidator) TagName() string {
+	return greaterThanTagName
+}
+
+func (greaterThanTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldNumericTagValidScopes
+}
+
+var (
+	greaterThanFieldValidator         = types.Name{Package: libValidationPkg, Name: "GreaterThanField"}
+	greaterThanFieldFloatValidator    = types.Name{Package: libValidationPkg, Name: "GreaterThanFieldFloat"}
+	greaterThanFieldQuantityValidator = types.Name{Package: libValidationPkg, Name: "GreaterThanFieldQuantity"}
+)
+
+func (greaterThanTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
+	return crossFieldNumericValidations(context, greaterThanTagName, payload,
+		greaterThanFieldValidator, greaterThanFieldFloatValidator, greaterThanFieldQuantityValidator)
+}
+
+func (v greaterThanTagValidator) Docs() TagDoc {
+	return crossFieldNumericDocs(v.TagName(), "greater than")
+}
+
+// crossFieldNumericValidations is the shared GetValidations body for
+// lessThanTagValidator and greaterThanTagValidator: resolve payload as a
+// sibling field via FieldReference, check the field's own type is one of
+// the ordered numeric kinds, and emit a call to whichever of intFn/floatFn/
+// quantityFn matches it.
+func crossFieldNumericValidations(context Context, tagName, payload string, intFn, floatFn, quantityFn types.Name) (Validations, error) {
+	var result Validations
+
+	field, ok, err := FieldReference(context, payload)
+	if err != nil {
+		return result, err
+	}
+	if !ok {
+		return result, fmt.Errorf("must reference another field by its JSON name, got %q", payload)
+	}
+
+	t := nonPointer(nativeType(context.Type))
+	switch {
+	case types.IsInteger(t):
+		result.AddFunction(Function(tagName, DefaultFlags, intFn, field, field.FieldName))
+	case t == types.Float32 || t == types.Float64:
+		result.AddFunction(Function(tagName, DefaultFlags, floatFn, field, field.FieldName))
+	case isQuantityType(t):
+		result.AddFunction(Function(tagName, DefaultFlags, quantityFn, field, field.FieldName))
+	default:
+		return result, fmt.Errorf("can only be used on integer, float, or resource.Quantity types (%s)", rootTypeString(context.Type, t))
+	}
+	return result, nil
+}
+
+func crossFieldNumericDocs(tagName, verb string) TagDoc {
+	return TagDoc{
+		Tag:    tagName,
+		Scopes: crossFieldNumericTagValidScopes.UnsortedList(),
+		Description: fmt.Sprintf("Indicates that a numeric field must be strictly %s a sibling field's value."+
+			" Supports integer, float, and resource.Quantity fields. Degrades to a no-op if either field"+
+			" is a nil pointer, and is recorded with an origin so update ratcheting still applies.", verb),
+		Payloads: []TagPayloadDoc{{
+			Description: "<field name>",
+			Docs:        fmt.Sprintf("This field must be %s the named sibling field's value.", verb),
+		}},
+	}
+}
+
+// sumEqualsTagValidator implements
+// +k8s:sumEquals=<fieldA>,<fieldB>,...=<targetField>: the sum of two or
+// more sibling fields must equal another sibling field. Every named field
+// is resolved with the same FieldReference mechanism +k8s:minimum,
+// +k8s:maximum, +k8s:lessThan and +k8s:greaterThan use.
+type sumEqualsTagValidator struct{}
+
+func (sumEqualsTagValidator) Init(_ Config) {}
+
+func (sumEqualsTagValidator) TagName() string {
+	return sumEqualsTagName
+}
+
+func (sumEqualsTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldNumericTagValidScopes
+}
+
+var (
+	sumEqualsFieldValidator      = types.Name{Package: libValidationPkg, Name: "SumEqualsField"}
+	sumEqualsFieldFloatValidator = types.Name{Package: libValidationPkg, Name: "SumEqualsFieldFloat"}
+)
+
+func (sumEqualsTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
+	var result Validations
+
+	parts := strings.SplitN(payload, "=", 2)
+	if len(parts) != 2 {
+		return result, fmt.Errorf("payload must have the form <fieldA>,<fieldB>,...=<targetField>, got %q", payload)
+	}
+	addendNames := strings.Split(parts[0], ",")
+	if len(addendNames) < 2 {
+		return result, fmt.Errorf("requires at least two addend fields, got %q", parts[0])
+	}
+	targetName := parts[1]
+
+	targetField, ok, err := FieldReference(context, targetName)
+	if err != nil {
+		return result, err
+	}
+	if !ok {
+		return result, fmt.Errorf("target field %q not found", targetName)
+	}
+
+	t := nonPointer(nativeType(context.Type))
+	var fn types.Name
+	switch {
+	case types.IsInteger(t):
+		fn = sumEqualsFieldValidator
+	case t == types.Float32 || t == types.Float64:
+		fn = sumEqualsFieldFloatValidator
+	default:
+		return result, fmt.Errorf("can only be used on integer or float types (%s)", rootTypeString(context.Type, t))
+	}
+
+	args := make([]interface{}, 0, len(addendNames)+2)
+	args = append(args, targetField)
+	for _, name := range addendNames {
+		addendField, ok, err := FieldReference(context, name)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			return result, fmt.Errorf("addend field %q not found", name)
+		}
+		args = append(args, addendField)
+	}
+	args = append(args, addendNames)
+
+	result.AddFunction(Function(sumEqualsTagName, DefaultFlags, fn, args...))
+	return result, nil
+}
+
+func (v sumEqualsTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    v.TagName(),
+		Scopes: crossFieldNumericTagValidScopes.UnsortedList(),
+		Description: "Indicates that the sum of two or more sibling fields must equal another sibling field." +
+			" Supports integer and float fields. Degrades to a no-op if any referenced field is a nil" +
+			" pointer, and is recorded with an origin so update ratcheting still applies.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<fieldA>,<fieldB>,...=<targetField>",
+			Docs:        "The named addend fields' values must sum to the named target field's value.",
+		}},
+		Usage: `+k8s:sumEquals=readyReplicas,unavailableReplicas=replicas`,
+	}
+}
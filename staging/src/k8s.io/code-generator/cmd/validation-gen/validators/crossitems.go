@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	uniqueItemsByTagName   = "k8s:uniqueItemsBy"
+	itemsOrderedByTagName  = "k8s:itemsOrderedBy"
+	itemsOrderedAscending  = "asc"
+	itemsOrderedDescending = "desc"
+)
+
+func init() {
+	RegisterTagValidator(&uniqueItemsByTagValidator{})
+	RegisterTagValidator(&itemsOrderedByTagValidator{})
+}
+
+// uniqueItemsByTagValidator implements +k8s:uniqueItemsBy(fields: "a,b"), a
+// generalization of +k8s:uniqueItems' struct-element fallback that lets the
+// caller name the fields a duplicate is judged by, instead of requiring them
+// to be exactly the list's +k8s:listMapKey fields. With no `fields`
+// argument it auto-derives the same way +k8s:uniqueItems does: from the
+// field's +k8s:listMapKey fields.
+type uniqueItemsByTagValidator struct {
+	validator   Validator
+	byFieldPath map[string]*listMetadata
+}
+
+func (utv *uniqueItemsByTagValidator) Init(cfg Config) {
+	utv.validator = cfg.Validator
+	if utv.byFieldPath == nil {
+		utv.byFieldPath = make(map[string]*listMetadata)
+	}
+}
+
+func (uniqueItemsByTagValidator) TagName() string {
+	return uniqueItemsByTagName
+}
+
+var crossItemsTagValidScopes = sets.New(ScopeField)
+
+func (uniqueItemsByTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossItemsTagValidScopes
+}
+
+// LateTagValidator ensures this runs after listMapKey tags are processed,
+// needed for the no-`fields` auto-derive case.
+func (uniqueItemsByTagValidator) LateTagValidator() {}
+
+func (utv *uniqueItemsByTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	elemT, err := sliceOfStructElemType(context, uniqueItemsByTagName)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	fieldNames, err := crossItemsFieldsArg(tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	if len(fieldNames) == 0 {
+		listMap, found := utv.byFieldPath[context.Path.String()]
+		if !found || !listMap.declaredAsMap || len(listMap.keyFields) == 0 {
+			return Validations{}, fmt.Errorf("%s requires a `fields` argument, or +k8s:listType=map and at least one +k8s:listMapKey=... annotation to auto-derive it", uniqueItemsByTagName)
+		}
+		fieldNames = listMap.keyFields
+	} else {
+		for i, jsonName := range fieldNames {
+			member := util.GetMemberByJSON(elemT, jsonName)
+			if member == nil {
+				return Validations{}, fmt.Errorf("list item has no field with JSON name %q", jsonName)
+			}
+			fieldNames[i] = member.Name
+		}
+	}
+
+	keyFn, err := createListMapKeyFn(elemT, fieldNames)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	result := Validations{}
+	result.AddFunction(Function(uniqueItemsByTagName, DefaultFlags, uniqueItemsByKeyValidator, keyFn))
+	return result, nil
+}
+
+func (utv uniqueItemsByTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    utv.TagName(),
+		Scopes: utv.ValidScopes().UnsortedList(),
+		Description: "Declares that all items of a list of structs must have a unique projection onto the named " +
+			"fields. With no `fields` argument, the list's +k8s:listMapKey fields are used, the same as " +
+			"+k8s:uniqueItems' struct fallback.",
+		Usage: `+k8s:uniqueItemsBy(fields: "a,b")`,
+		Args: []TagArgDoc{{
+			Description: `fields="<json-name>[,<json-name>...]": the field(s) the uniqueness projection is computed over`,
+			Type:        codetags.ArgTypeString,
+		}},
+	}
+}
+
+// itemsOrderedByTagValidator implements
+// +k8s:itemsOrderedBy(fields: "a,b", direction: "asc"), verifying a list of
+// structs is sorted by the named fields (compared lexicographically, most
+// significant first), ascending unless `direction: "desc"` is given.
+type itemsOrderedByTagValidator struct {
+	validator Validator
+}
+
+func (otv *itemsOrderedByTagValidator) Init(cfg Config) {
+	otv.validator = cfg.Validator
+}
+
+func (itemsOrderedByTagValidator) TagName() string {
+	return itemsOrderedByTagName
+}
+
+func (itemsOrderedByTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossItemsTagValidScopes
+}
+
+var itemsOrderedByValidator = types.Name{Package: libValidationPkg, Name: "ItemsOrderedBy"}
+
+func (otv *itemsOrderedByTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	elemT, err := sliceOfStructElemType(context, itemsOrderedByTagName)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	fieldNames, err := crossItemsFieldsArg(tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	if len(fieldNames) == 0 {
+		return Validations{}, fmt.Errorf("%s requires a `fields` argument", itemsOrderedByTagName)
+	}
+
+	direction := itemsOrderedAscending
+	for _, arg := range tag.Args {
+		if arg.Name == "direction" {
+			switch arg.Value {
+			case itemsOrderedAscending, itemsOrderedDescending:
+				direction = arg.Value
+			default:
+				return Validations{}, fmt.Errorf("direction must be %q or %q, got %q", itemsOrderedAscending, itemsOrderedDescending, arg.Value)
+			}
+		}
+	}
+
+	var members []*types.Member
+	for _, jsonName := range fieldNames {
+		member := util.GetMemberByJSON(elemT, jsonName)
+		if member == nil {
+			return Validations{}, fmt.Errorf("list item has no field with JSON name %q", jsonName)
+		}
+		t := util.NonPointer(util.NativeType(member.Type))
+		if !isOrderedType(t) {
+			return Validations{}, fmt.Errorf("field %q is not an ordered type (must be an integer, float, or string), got %s", jsonName, t.String())
+		}
+		members = append(members, member)
+	}
+
+	lessFn, err := createItemsOrderedLessFn(elemT, members, direction)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	result := Validations{}
+	result.AddFunction(Function(itemsOrderedByTagName, DefaultFlags, itemsOrderedByValidator, lessFn))
+	return result, nil
+}
+
+func isOrderedType(t *types.Type) bool {
+	return types.IsInteger(t) || t == types.Float32 || t == types.Float64 || t == types.String
+}
+
+// createItemsOrderedLessFn builds a FunctionLiteral for
+// `func(a, b *elemT) bool`, comparing a and b lexicographically over
+// members (most significant first) using Go's native ordering operators,
+// which apply directly to every type isOrderedType accepts.
+func createItemsOrderedLessFn(elemT *types.Type, members []*types.Member, direction string) (FunctionLiteral, error) {
+	lt, gt := "<", ">"
+	if direction == itemsOrderedDescending {
+		lt, gt = gt, lt
+	}
+
+	var sb strings.Builder
+	for _, m := range members {
+		fmt.Fprintf(&sb, "if a.%s %s b.%s { return true }\n", m.Name, lt, m.Name)
+		fmt.Fprintf(&sb, "if a.%s %s b.%s { return false }\n", m.Name, gt, m.Name)
+	}
+	sb.WriteString("return false")
+
+	return FunctionLiteral{
+		Parameters: []ParamResult{{"a", types.PointerTo(elemT)}, {"b", types.PointerTo(elemT)}},
+		Results:    []ParamResult{{"", types.Bool}},
+		Body:       sb.String(),
+	}, nil
+}
+
+func (otv itemsOrderedByTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    otv.TagName(),
+		Scopes: otv.ValidScopes().UnsortedList(),
+		Description: "Declares that a list of structs must be sorted by the named fields, compared " +
+			"lexicographically (most significant field first).",
+		Usage: `+k8s:itemsOrderedBy(fields: "a,b", direction: "asc")`,
+		Args: []TagArgDoc{
+			{
+				Description: `fields="<json-name>[,<json-name>...]": the field(s) to sort by, most significant first`,
+				Type:        codetags.ArgTypeString,
+				Required:    true,
+			},
+			{
+				Description: `direction="asc"|"desc": sort direction (default: asc)`,
+				Type:        codetags.ArgTypeString,
+			},
+		},
+	}
+}
+
+// sliceOfStructElemType validates that context.Type (or its non-pointer,
+// native form) is a list of structs, the shape both cross-item tags in this
+// file require, and returns the element type.
+func sliceOfStructElemType(context Context, tagName string) (*types.Type, error) {
+	t := util.NonPointer(util.NativeType(context.Type))
+	if t.Kind != types.Slice {
+		return nil, fmt.Errorf("%s can only be used on list types (%s)", tagName, rootTypeString(context.Type, t))
+	}
+	elemT := util.NonPointer(util.NativeType(t.Elem))
+	if elemT.Kind != types.Struct {
+		return nil, fmt.Errorf("%s can only be used on a list of structs (%s)", tagName, elemT.String())
+	}
+	return elemT, nil
+}
+
+// crossItemsFieldsArg reads the optional `fields` named argument shared by
+// +k8s:uniqueItemsBy and +k8s:itemsOrderedBy: a comma-separated list of JSON
+// field names. Returns nil if no `fields` argument was given.
+func crossItemsFieldsArg(tag codetags.Tag) ([]string, error) {
+	for _, arg := range tag.Args {
+		if arg.Name != "fields" {
+			continue
+		}
+		if arg.Value == "" {
+			return nil, fmt.Errorf("fields argument cannot be empty")
+		}
+		var names []string
+		for _, name := range strings.Split(arg.Value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				return nil, fmt.Errorf("fields argument contains an empty field name")
+			}
+			names = append(names, name)
+		}
+		return names, nil
+	}
+	return nil, nil
+}
@@ -19,6 +19,9 @@ package validators
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -29,10 +32,14 @@ import (
 
 const (
 	eqOneOfTagName = "k8s:eqOneOf"
+	notInTagName   = "k8s:notIn"
+	neOneOfTagName = "k8s:neOneOf"
 )
 
 func init() {
 	RegisterTagValidator(eqOneOfTagValidator{})
+	RegisterTagValidator(notInTagValidator{tagName: notInTagName})
+	RegisterTagValidator(notInTagValidator{tagName: neOneOfTagName})
 }
 
 type eqOneOfTagValidator struct{}
@@ -50,7 +57,10 @@ func (eqOneOfTagValidator) ValidScopes() sets.Set[Scope] {
 }
 
 var (
-	eqOneOfValidator = types.Name{Package: libValidationPkg, Name: "EqOneOf"}
+	eqOneOfValidator      = types.Name{Package: libValidationPkg, Name: "EqOneOf"}
+	eqOneOfWarnValidator  = types.Name{Package: libValidationPkg, Name: "EqOneOfWarn"}
+	eqOneOfAuditValidator = types.Name{Package: libValidationPkg, Name: "EqOneOfAudit"}
+	eqOneOfFoldValidator  = types.Name{Package: libValidationPkg, Name: "EqOneOfFold"}
 )
 
 func buildSliceLiteral[T any](fieldType *types.Type, nativeType *types.Type, values []T, format func(T) string) Literal {
@@ -75,94 +85,292 @@ func buildSliceLiteral[T any](fieldType *types.Type, nativeType *types.Type, val
 }
 
 func (v eqOneOfTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	caseInsensitive := false
+	var enforcementArgs []codetags.Arg
+	for _, arg := range tag.Args {
+		if arg.Name != "caseInsensitive" {
+			enforcementArgs = append(enforcementArgs, arg)
+			continue
+		}
+		switch arg.Value {
+		case "true":
+			caseInsensitive = true
+		case "false":
+			caseInsensitive = false
+		default:
+			return Validations{}, fmt.Errorf("caseInsensitive must be \"true\" or \"false\", got %q", arg.Value)
+		}
+	}
+
+	action, _, err := parseEnforcementArgs(codetags.Tag{Args: enforcementArgs})
+	if err != nil {
+		return Validations{}, err
+	}
+	if caseInsensitive && action != actionDeny {
+		return Validations{}, fmt.Errorf("caseInsensitive is only supported with the default action (deny)")
+	}
+
 	t := util.NonPointer(util.NativeType(context.Type))
 	fieldType := util.NonPointer(context.Type)
 
+	if caseInsensitive && t != types.String {
+		return Validations{}, fmt.Errorf("caseInsensitive can only be used with string types (%s)", rootTypeString(context.Type, t))
+	}
+
+	if tag.ValueType == codetags.ValueTypeString {
+		if pkgPath, prefix, ok := parseConstsPrefixPayload(tag.Value); ok {
+			return Validations{}, fmt.Errorf("%s: <consts:%q> payload form is not supported: expanding %q's exported %q-prefixed constants requires a Universe/type-lookup entry point that this tag validator does not have (see atpath.go's buildAtPathExtractor NOTE for the same limitation); list the allowed values as a JSON array instead", eqOneOfTagName, tag.Value, pkgPath, prefix)
+		}
+	}
+
+	if caseInsensitive {
+		values, err := parseComparableArray(context, t, fieldType, tag, eqOneOfTagName)
+		if err != nil {
+			return Validations{}, err
+		}
+		strs := values.([]string)
+		for i, s := range strs {
+			strs[i] = strings.ToLower(s)
+		}
+		// Normalize at codegen time so the runtime check is a plain
+		// lowercased membership test, not a per-call transform.
+		sort.Strings(strs)
+		fn := Function(v.TagName(), DefaultFlags, eqOneOfFoldValidator, buildSliceLiteral(fieldType, types.String, strs, func(s string) string {
+			return fmt.Sprintf("%q", s)
+		}))
+		return Validations{Functions: []FunctionGen{fn}}, nil
+	}
+
+	literal, err := literalForComparableArray(context, t, fieldType, tag, eqOneOfTagName)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	validatorFn := eqOneOfValidator
+	switch action {
+	case actionWarn:
+		validatorFn = eqOneOfWarnValidator
+	case actionAudit:
+		validatorFn = eqOneOfAuditValidator
+	}
+
+	fn := Function(v.TagName(), DefaultFlags, validatorFn, literal)
+	return Validations{Functions: []FunctionGen{fn}}, nil
+}
+
+// parseConstsPrefixPayload recognizes the alternative
+// <consts:"pkg/path.PrefixName"> payload form -- it does not attempt to
+// resolve it (see the caller for why) and exists only so that form can be
+// detected and rejected with a clear error instead of failing the generic
+// JSON-array parse below with a confusing message. It returns ok=false for
+// any payload not shaped like this form, leaving normal JSON-array payloads
+// unaffected.
+func parseConstsPrefixPayload(value string) (pkgPath, prefix string, ok bool) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, `<consts:"`) || !strings.HasSuffix(value, `">`) {
+		return "", "", false
+	}
+	inner := strings.TrimSuffix(strings.TrimPrefix(value, `<consts:"`), `">`)
+	dot := strings.LastIndex(inner, ".")
+	if dot < 0 {
+		return inner, "", true
+	}
+	return inner[:dot], inner[dot+1:], true
+}
+
+// parseComparableArray parses tag's JSON-array payload into a []string,
+// []bool, []int, or []float64 (matching t), validating that t is a
+// supported comparable type for tagName. The returned value's concrete type
+// mirrors t: types.String -> []string, types.Bool -> []bool, any other
+// integer kind -> []int, types.Float32/types.Float64 -> []float64.
+func parseComparableArray(context Context, t, fieldType *types.Type, tag codetags.Tag, tagName string) (interface{}, error) {
 	if !util.IsDirectComparable(t) {
-		return Validations{}, fmt.Errorf("can only be used on comparable types (e.g. string, int, bool), but got %s", rootTypeString(context.Type, t))
+		return nil, fmt.Errorf("can only be used on comparable types (e.g. string, int, bool), but got %s", rootTypeString(context.Type, t))
 	}
 
 	if tag.ValueType != codetags.ValueTypeString {
-		return Validations{}, fmt.Errorf("missing required payload in backticks")
+		return nil, fmt.Errorf("missing required payload in backticks")
 	}
 
 	var rawValues []interface{}
 	if err := json.Unmarshal([]byte(tag.Value), &rawValues); err != nil {
-		return Validations{}, fmt.Errorf("payload must be a valid JSON array, got: %s (error: %w)", tag.Value, err)
+		return nil, fmt.Errorf("payload must be a valid JSON array, got: %s (error: %w)", tag.Value, err)
 	}
 
 	if len(rawValues) == 0 {
-		return Validations{}, fmt.Errorf("array cannot be empty")
+		return nil, fmt.Errorf("array cannot be empty")
 	}
 
-	var literal Literal
-
 	switch t {
 	case types.String:
 		values := make([]string, 0, len(rawValues))
 		for i, raw := range rawValues {
 			str, ok := raw.(string)
 			if !ok {
-				return Validations{}, fmt.Errorf("array element at index %d must be a string, got %T", i, raw)
+				return nil, fmt.Errorf("array element at index %d must be a string, got %T", i, raw)
 			}
 			values = append(values, str)
 		}
-		literal = buildSliceLiteral(fieldType, types.String, values, func(s string) string {
-			return fmt.Sprintf("%q", s)
-		})
+		return values, nil
 
 	case types.Bool:
 		values := make([]bool, 0, len(rawValues))
 		for i, raw := range rawValues {
 			b, ok := raw.(bool)
 			if !ok {
-				return Validations{}, fmt.Errorf("array element at index %d must be a bool, got %T", i, raw)
+				return nil, fmt.Errorf("array element at index %d must be a bool, got %T", i, raw)
 			}
 			values = append(values, b)
 		}
-		literal = buildSliceLiteral(fieldType, types.Bool, values, func(b bool) string {
-			return fmt.Sprintf("%t", b)
-		})
+		return values, nil
+
+	case types.Float32, types.Float64:
+		values := make([]float64, 0, len(rawValues))
+		for i, raw := range rawValues {
+			// JSON unmarshals numbers as float64.
+			f, ok := raw.(float64)
+			if !ok {
+				return nil, fmt.Errorf("array element at index %d must be a number, got %T", i, raw)
+			}
+			if math.IsNaN(f) {
+				// Unreachable via encoding/json (which never decodes NaN),
+				// but guarded explicitly since NaN != NaN would otherwise
+				// silently make every EqOneOf/NotIn comparison against this
+				// value always fail.
+				return nil, fmt.Errorf("array element at index %d must not be NaN", i)
+			}
+			values = append(values, f)
+		}
+		return values, nil
 
 	default:
-		if types.IsInteger(t) {
-			values := make([]int, 0, len(rawValues))
-			for i, raw := range rawValues {
-				// JSON unmarshals numbers as float64.
-				f, ok := raw.(float64)
-				if !ok {
-					return Validations{}, fmt.Errorf("array element at index %d must be a number, got %T", i, raw)
-				}
-					// Check if whole number by checking float == (float -> int -> float).
-					intVal := int(f)
-					if float64(intVal) != f {
-					return Validations{}, fmt.Errorf("array element at index %d must be an integer, got %v", i, f)
-				}
-				values = append(values, intVal)
+		if !types.IsInteger(t) {
+			return nil, fmt.Errorf("unsupported type for %q tag: %s", tagName, t.Name)
+		}
+		values := make([]int, 0, len(rawValues))
+		for i, raw := range rawValues {
+			// JSON unmarshals numbers as float64.
+			f, ok := raw.(float64)
+			if !ok {
+				return nil, fmt.Errorf("array element at index %d must be a number, got %T", i, raw)
 			}
-			literal = buildSliceLiteral(fieldType, t, values, func(i int) string {
-				return fmt.Sprintf("%d", i)
-			})
-		} else {
-			return Validations{}, fmt.Errorf("unsupported type for 'eqOneOf' tag: %s", t.Name)
+			// Check if whole number by checking float == (float -> int -> float).
+			intVal := int(f)
+			if float64(intVal) != f {
+				return nil, fmt.Errorf("array element at index %d must be an integer, got %v", i, f)
+			}
+			values = append(values, intVal)
 		}
+		return values, nil
 	}
+}
 
-	fn := Function(v.TagName(), DefaultFlags, eqOneOfValidator, literal)
-	return Validations{Functions: []FunctionGen{fn}}, nil
+// literalForComparableArray is parseComparableArray followed by rendering
+// the parsed values as a Go slice-literal Literal for t.
+func literalForComparableArray(context Context, t, fieldType *types.Type, tag codetags.Tag, tagName string) (Literal, error) {
+	parsed, err := parseComparableArray(context, t, fieldType, tag, tagName)
+	if err != nil {
+		return "", err
+	}
+	switch values := parsed.(type) {
+	case []string:
+		return buildSliceLiteral(fieldType, types.String, values, func(s string) string {
+			return fmt.Sprintf("%q", s)
+		}), nil
+	case []bool:
+		return buildSliceLiteral(fieldType, types.Bool, values, func(b bool) string {
+			return fmt.Sprintf("%t", b)
+		}), nil
+	case []float64:
+		bitSize := 64
+		if t == types.Float32 {
+			bitSize = 32
+		}
+		return buildSliceLiteral(fieldType, t, values, func(f float64) string {
+			return strconv.FormatFloat(f, 'g', -1, bitSize)
+		}), nil
+	default:
+		return buildSliceLiteral(fieldType, t, values.([]int), func(i int) string {
+			return fmt.Sprintf("%d", i)
+		}), nil
+	}
 }
 
 func (v eqOneOfTagValidator) Docs() TagDoc {
 	return TagDoc{
-		Tag:              v.TagName(),
-		Scopes:           v.ValidScopes().UnsortedList(),
-		Description:      "Verifies the field's value is equal to one of the allowed values. Supports string, integer, and bool types.",
+		Tag:    v.TagName(),
+		Scopes: v.ValidScopes().UnsortedList(),
+		Description: "Verifies the field's value is equal to one of the allowed values. Supports string, integer, bool, and float32/float64 types." +
+			" By default a violation denies the request; pass action=warn or action=audit to instead surface it as a non-blocking warning or audit annotation.",
+		Args: []TagArgDoc{{
+			Description: "action=<deny|warn|audit>: the enforcement action to take on violation (default: deny)",
+		}, {
+			Description: "scope=<label>: an opaque label grouping related enforcement actions (default: all)",
+		}, {
+			Description: "caseInsensitive=<true|false>: compare string values case-insensitively (default: false; string fields only, incompatible with action=warn/audit)",
+		}},
+		PayloadsRequired: true,
+		PayloadsType:     codetags.ValueTypeString,
+		Payloads: []TagPayloadDoc{{
+			Description: `JSON array`,
+			Docs:        `A JSON array of allowed values. Examples: ["a","b","c"] for strings, [1,2,3] for integers, [true,false] for bools, [1.5,2.5] for floats (NaN is rejected).`,
+		}},
+		Usage: `+k8s:eqOneOf=["a","b","c"] or +k8s:eqOneOf=[1,2,3],action=warn or +k8s:eqOneOf=[true,false],action=audit,scope=webhook`,
+	}
+}
+
+// notInTagValidator backs both +k8s:notIn and its shorthand alias
+// +k8s:neOneOf; the two tag names share this one implementation (the same
+// pattern mapval.go's eachMapKeyTagValidator uses for eachMapKey/eachKey),
+// so tagName records which name a given registration should answer to.
+type notInTagValidator struct {
+	tagName string
+}
+
+func (notInTagValidator) Init(_ Config) {}
+
+func (v notInTagValidator) TagName() string {
+	return v.tagName
+}
+
+var notInTagValidScopes = sets.New(ScopeAny)
+
+func (notInTagValidator) ValidScopes() sets.Set[Scope] {
+	return notInTagValidScopes
+}
+
+var notInValidator = types.Name{Package: libValidationPkg, Name: "NotIn"}
+
+func (v notInTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	t := util.NonPointer(util.NativeType(context.Type))
+	fieldType := util.NonPointer(context.Type)
+
+	literal, err := literalForComparableArray(context, t, fieldType, tag, v.tagName)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	fn := Function(v.TagName(), DefaultFlags, notInValidator, literal)
+	return Validations{Functions: []FunctionGen{fn}}, nil
+}
+
+func (v notInTagValidator) Docs() TagDoc {
+	doc := TagDoc{
+		Tag:    v.TagName(),
+		Scopes: v.ValidScopes().UnsortedList(),
+		Description: "Verifies the field's value is not equal to any of the disallowed values." +
+			" The inverse of k8s:eqOneOf. Supports string, integer, bool, and float32/float64 types.",
 		PayloadsRequired: true,
 		PayloadsType:     codetags.ValueTypeString,
 		Payloads: []TagPayloadDoc{{
 			Description: `JSON array`,
-			Docs:        `A JSON array of allowed values. Examples: ["a","b","c"] for strings, [1,2,3] for integers, [true,false] for bools.`,
+			Docs:        `A JSON array of disallowed values. Examples: ["a","b","c"] for strings, [1,2,3] for integers, [true,false] for bools, [1.5,2.5] for floats (NaN is rejected).`,
 		}},
-		Usage: `+k8s:eqOneOf=["a","b","c"] or +k8s:eqOneOf=[1,2,3] or +k8s:eqOneOf=[true,false]`,
+		Usage: `+k8s:notIn=["a","b","c"]`,
+	}
+	if v.tagName == neOneOfTagName {
+		doc.Description = "Shorthand alias for +k8s:notIn."
+		doc.Usage = `+k8s:neOneOf=["a","b","c"]`
 	}
+	return doc
 }
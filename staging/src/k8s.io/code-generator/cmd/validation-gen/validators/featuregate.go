@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/gengo/v2/codetags"
+)
+
+const (
+	ifFeatureEnabledTag  = "k8s:ifFeatureEnabled"
+	ifFeatureDisabledTag = "k8s:ifFeatureDisabled"
+)
+
+func init() {
+	RegisterTagValidator(&ifFeatureTagValidator{true, nil})
+	RegisterTagValidator(&ifFeatureTagValidator{false, nil})
+}
+
+// ifFeatureTagValidator is k8s:ifOptionEnabled/k8s:ifOptionDisabled's
+// counterpart for a named Kubernetes feature gate rather than a per-request
+// validation option: it gates its payload on
+// Conditions{FeatureEnabled/FeatureDisabled: <gate name>} instead of
+// Conditions{OptionEnabled/OptionDisabled: <option name>}, so the same
+// feature-gate lifecycle already used for alpha/beta APIs elsewhere in
+// Kubernetes can toggle a validation without plumbing a separate
+// per-request option for it.
+type ifFeatureTagValidator struct {
+	enabled   bool
+	validator Validator
+}
+
+func (iftv *ifFeatureTagValidator) Init(cfg Config) {
+	iftv.validator = cfg.Validator
+}
+
+func (iftv ifFeatureTagValidator) TagName() string {
+	if iftv.enabled {
+		return ifFeatureEnabledTag
+	}
+	return ifFeatureDisabledTag
+}
+
+var ifFeatureTagValidScopes = sets.New(ScopeAny)
+
+func (ifFeatureTagValidator) ValidScopes() sets.Set[Scope] {
+	return ifFeatureTagValidScopes
+}
+
+func (iftv ifFeatureTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	gateName := tag.Args[0].Value
+	result := Validations{}
+	validations, err := iftv.validator.ExtractValidations(context, *tag.ValueTag)
+	if err != nil {
+		return Validations{}, err
+	}
+	for _, fn := range validations.Functions {
+		if iftv.enabled {
+			result.Functions = append(result.Functions, fn.WithConditions(Conditions{FeatureEnabled: gateName}))
+		} else {
+			result.Functions = append(result.Functions, fn.WithConditions(Conditions{FeatureDisabled: gateName}))
+		}
+	}
+	result.Variables = append(result.Variables, validations.Variables...)
+	return result, nil
+}
+
+func (iftv ifFeatureTagValidator) Docs() TagDoc {
+	doc := TagDoc{
+		Tag: iftv.TagName(),
+		Args: []TagArgDoc{{
+			Description: "<feature-gate>",
+			Type:        codetags.ArgTypeString,
+			Required:    true,
+		}},
+		Scopes: iftv.ValidScopes().UnsortedList(),
+	}
+
+	doc.PayloadsType = codetags.ValueTypeTag
+	doc.PayloadsRequired = true
+	if iftv.enabled {
+		doc.Description = "Declares a validation that only applies when a feature gate is enabled."
+		doc.Payloads = []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "This validation tag will be evaluated only if the named feature gate is enabled, checked against k8s.io/apiserver/pkg/util/feature.DefaultFeatureGate (or an injectable FeatureGate from the validation Context, where supported) at runtime.",
+		}}
+	} else {
+		doc.Description = "Declares a validation that only applies when a feature gate is disabled."
+		doc.Payloads = []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "This validation tag will be evaluated only if the named feature gate is disabled, checked against k8s.io/apiserver/pkg/util/feature.DefaultFeatureGate (or an injectable FeatureGate from the validation Context, where supported) at runtime.",
+		}}
+	}
+	return doc
+}
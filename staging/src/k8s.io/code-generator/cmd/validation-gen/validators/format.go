@@ -17,8 +17,10 @@ limitations under the License.
 package validators
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/code-generator/cmd/validation-gen/util"
@@ -50,12 +52,56 @@ func (formatTagValidator) ValidScopes() sets.Set[Scope] {
 
 var (
 	// Keep this list alphabetized.
-	generateNameValidator = types.Name{Package: libValidationPkg, Name: "GenerateName"}
-	ipSloppyValidator     = types.Name{Package: libValidationPkg, Name: "IPSloppy"}
-	longNameValidator     = types.Name{Package: libValidationPkg, Name: "LongName"}
-	shortNameValidator    = types.Name{Package: libValidationPkg, Name: "ShortName"}
+	generateNameValidator  = types.Name{Package: libValidationPkg, Name: "GenerateName"}
+	ipSloppyValidator      = types.Name{Package: libValidationPkg, Name: "IPSloppy"}
+	longNameValidator      = types.Name{Package: libValidationPkg, Name: "LongName"}
+	shortNameValidator     = types.Name{Package: libValidationPkg, Name: "ShortName"}
+	withSuggestionsWrapper = types.Name{Package: libValidationPkg, Name: "WithSuggestions"}
 )
 
+// formatValidators holds the pluggable registry of named formats: a format
+// name (e.g. "uuid") maps to the validator function that implements it.
+// RegisterFormatValidator adds to it; getFormatValidationFunction consults
+// it for any format name not already handled above.
+var formatValidators = map[string]types.Name{}
+
+// RegisterFormatValidator declares that the k8s:format tag payload name
+// should generate a call to validator, a function in
+// k8s.io/apimachinery/pkg/api/validate with the standard
+// (ctx, op, fldPath, value, oldValue) validator signature. Call this from an
+// init() function: formats must be registered before any k8s:format tag
+// referencing them is processed.
+func RegisterFormatValidator(name string, validator types.Name) {
+	formatValidators[name] = validator
+}
+
+func init() {
+	// Keep this list alphabetized.
+	RegisterFormatValidator("absolutePath", types.Name{Package: libValidationPkg, Name: "AbsolutePath"})
+	RegisterFormatValidator("base64", types.Name{Package: libValidationPkg, Name: "Base64"})
+	RegisterFormatValidator("cidr", types.Name{Package: libValidationPkg, Name: "CIDR"})
+	RegisterFormatValidator("dns1035Label", types.Name{Package: libValidationPkg, Name: "DNS1035Label"})
+	RegisterFormatValidator("dns1123Subdomain", types.Name{Package: libValidationPkg, Name: "DNS1123Subdomain"})
+	RegisterFormatValidator("ipv4", types.Name{Package: libValidationPkg, Name: "IPv4"})
+	RegisterFormatValidator("ipv6", types.Name{Package: libValidationPkg, Name: "IPv6"})
+	RegisterFormatValidator("url", types.Name{Package: libValidationPkg, Name: "URL"})
+	RegisterFormatValidator("uuid", types.Name{Package: libValidationPkg, Name: "UUID"})
+
+	// JSON Schema 2020-12 "Defined Formats" -- see
+	// https://json-schema.org/draft/2020-12/json-schema-validation#name-defined-formats.
+	// ipv4/ipv6/uuid are already registered above under those same names.
+	RegisterFormatValidator("date", types.Name{Package: libValidationPkg, Name: "Date"})
+	RegisterFormatValidator("date-time", types.Name{Package: libValidationPkg, Name: "DateTime"})
+	RegisterFormatValidator("duration", types.Name{Package: libValidationPkg, Name: "Duration"})
+	RegisterFormatValidator("email", types.Name{Package: libValidationPkg, Name: "Email"})
+	RegisterFormatValidator("hostname", types.Name{Package: libValidationPkg, Name: "Hostname"})
+	RegisterFormatValidator("idn-email", types.Name{Package: libValidationPkg, Name: "IDNEmail"})
+	RegisterFormatValidator("regex", types.Name{Package: libValidationPkg, Name: "Regex"})
+	RegisterFormatValidator("time", types.Name{Package: libValidationPkg, Name: "Time"})
+	RegisterFormatValidator("uri", types.Name{Package: libValidationPkg, Name: "URI"})
+	RegisterFormatValidator("uri-reference", types.Name{Package: libValidationPkg, Name: "URIReference"})
+}
+
 func (formatTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
 	// This tag can apply to value and pointer fields, as well as typedefs
 	// (which should never be pointers). We need to check the concrete type.
@@ -63,10 +109,34 @@ func (formatTagValidator) GetValidations(context Context, tag codetags.Tag) (Val
 		return Validations{}, fmt.Errorf("can only be used on string types (%s)", rootTypeString(context.Type, t))
 	}
 
+	var candidates []string
+	for _, arg := range tag.Args {
+		if arg.Name != "suggest" {
+			return Validations{}, fmt.Errorf("%s: unsupported argument %q", formatTagName, arg.Name)
+		}
+		if err := json.Unmarshal([]byte(arg.Value), &candidates); err != nil {
+			return Validations{}, fmt.Errorf("%s: suggest must be a JSON array of strings: %w", formatTagName, err)
+		}
+	}
+
 	var result Validations
-	if formatFunction, err := getFormatValidationFunction(tag.Value, context.Type); err != nil {
-		return result, err
-	} else {
+	// A comma-separated payload (e.g. "uri,hostname") asserts every listed
+	// format at once: each gets its own Function, and -- like any tag that
+	// emits more than one Function -- they all run and an error from any one
+	// of them fails validation, giving an AND of the formats.
+	for _, format := range strings.Split(tag.Value, ",") {
+		format = strings.TrimSpace(format)
+		formatFunction, err := getFormatValidationFunction(format, context.Type)
+		if err != nil {
+			return Validations{}, err
+		}
+		if len(candidates) > 0 {
+			formatFunction = Function(formatTagName, formatFunction.Flags, withSuggestionsWrapper,
+				WrapperFunction{formatFunction, context.Type},
+				buildSliceLiteral(types.String, types.String, candidates, func(s string) string {
+					return fmt.Sprintf("%q", s)
+				}))
+		}
 		result.AddFunction(formatFunction)
 	}
 	return result, nil
@@ -101,6 +171,9 @@ func getFormatValidationFunction(format string, objType *types.Type) (FunctionGe
 	if format == "k8s-short-name" {
 		return Function(formatTagName, DefaultFlags, shortNameValidator), nil
 	}
+	if validator, ok := formatValidators[format]; ok {
+		return Function(formatTagName, DefaultFlags, validator), nil
+	}
 	// TODO: Flesh out the list of validation functions
 
 	return FunctionGen{}, fmt.Errorf("unsupported validation format %q", format)
@@ -135,7 +208,21 @@ func (ftv formatTagValidator) Docs() TagDoc {
 		}, {
 			Description: "k8s-short-name",
 			Docs:        "This field holds a Kubernetes \"short name\", aka a \"DNS label\" value.",
+		}, {
+			Description: "absolutePath, base64, cidr, dns1035Label, dns1123Subdomain, ipv4, ipv6, url, uuid",
+			Docs:        "This field holds a value of the named well-known format. Additional formats can be added with RegisterFormatValidator.",
+		}, {
+			Description: "date, date-time, duration, email, hostname, idn-email, regex, time, uri, uri-reference",
+			Docs: "This field holds a value of the named JSON Schema 2020-12 \"Defined Format\" (see" +
+				" https://json-schema.org/draft/2020-12/json-schema-validation#name-defined-formats). ipv4, ipv6," +
+				" and uuid are also JSON Schema defined formats; they are listed above since they predate this set.",
+		}},
+		Args: []TagArgDoc{{
+			Description: `suggest=["candidate1","candidate2",...]: a JSON array of known-good values. When the field's value is` +
+				` rejected and one of these is a close match, the error's Detail gains a "did you mean X?" suggestion.` +
+				` Origin and Type are unaffected, so existing field.ErrorMatcher.ByOrigin() assertions keep passing.`,
 		}},
+		Docs:             "A comma-separated list of formats (e.g. \"uri,hostname\") asserts that the value satisfies all of them.",
 		PayloadsType:     codetags.ValueTypeString,
 		PayloadsRequired: true,
 	}
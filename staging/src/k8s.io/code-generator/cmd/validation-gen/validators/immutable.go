@@ -17,6 +17,9 @@ limitations under the License.
 package validators
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/code-generator/cmd/validation-gen/util"
 	"k8s.io/gengo/v2/codetags"
@@ -24,13 +27,15 @@ import (
 )
 
 const (
-	frozenTagName    = "k8s:frozen"
-	immutableTagName = "k8s:immutable"
+	frozenTagName        = "k8s:frozen"
+	immutableTagName     = "k8s:immutable"
+	immutableDeepTagName = "k8s:immutableDeep"
 )
 
 func init() {
 	RegisterTagValidator(frozenTagValidator{})
 	RegisterTagValidator(immutableTagValidator{})
+	RegisterTagValidator(immutableDeepTagValidator{})
 }
 
 type frozenTagValidator struct{}
@@ -52,23 +57,43 @@ var (
 	frozenReflectValidator = types.Name{Package: libValidationPkg, Name: "FrozenByReflect"}
 )
 
-func (frozenTagValidator) GetValidations(context Context, _ codetags.Tag) (Validations, error) {
+func (frozenTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
 	var result Validations
 
+	var fn FunctionGen
 	if util.IsDirectComparable(util.NonPointer(util.NativeType(context.Type))) {
-		result.AddFunction(Function(frozenTagName, DefaultFlags, frozenCompareValidator))
+		fn = Function(frozenTagName, DefaultFlags, frozenCompareValidator)
 	} else {
-		result.AddFunction(Function(frozenTagName, DefaultFlags, frozenReflectValidator))
+		fn = Function(frozenTagName, DefaultFlags, frozenReflectValidator)
 	}
 
+	cond, apply, err := subresourceRelaxationArgs(frozenTagName, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	if apply {
+		fn = fn.WithConditions(cond)
+	}
+
+	result.AddFunction(fn)
 	return result, nil
 }
 
 func (ftv frozenTagValidator) Docs() TagDoc {
 	return TagDoc{
-		Tag:         ftv.TagName(),
-		Scopes:      ftv.ValidScopes().UnsortedList(),
-		Description: "Indicates that a field may not be updated.",
+		Tag:    ftv.TagName(),
+		Scopes: ftv.ValidScopes().UnsortedList(),
+		Description: "Indicates that a field may not be updated. By default this does not apply to the" +
+			" \"status\" subresource, since the common case is that a controller must still be able to" +
+			" write status. Use exceptSubresources/onSubresources to override which subresource(s) this" +
+			" applies to.",
+		Args: []TagArgDoc{{
+			Description: `exceptSubresources=[...]: a JSON array holding at most one subresource name that this validation does not apply to (default: ["status"])`,
+			Type:        codetags.ArgTypeString,
+		}, {
+			Description: `onSubresources=[...]: a JSON array holding exactly one subresource name that this validation applies to exclusively`,
+			Type:        codetags.ArgTypeString,
+		}},
 	}
 }
 
@@ -92,7 +117,76 @@ var (
 	immutableReflectValidator          = types.Name{Package: libValidationPkg, Name: "ImmutableByReflect"}
 )
 
-func (itv immutableTagValidator) GetValidations(context Context, _ codetags.Tag) (Validations, error) {
+func (itv immutableTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	return immutableValidations(immutableTagName, context, tag, false)
+}
+
+func (itv immutableTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    itv.TagName(),
+		Scopes: itv.ValidScopes().UnsortedList(),
+		Description: "Indicates that a field can be set once (now or at creation), then becomes immutable." +
+			" Allows transition from unset to set, but forbids modify or clear operations. Fields with" +
+			" default values are considered already set. By default this does not apply to the \"status\"" +
+			" subresource, since the common case is that a controller must still be able to write status." +
+			" Use exceptSubresources/onSubresources to override which subresource(s) this applies to.",
+		Args: []TagArgDoc{{
+			Description: `exceptSubresources=[...]: a JSON array holding at most one subresource name that this validation does not apply to (default: ["status"])`,
+			Type:        codetags.ArgTypeString,
+		}, {
+			Description: `onSubresources=[...]: a JSON array holding exactly one subresource name that this validation applies to exclusively`,
+			Type:        codetags.ArgTypeString,
+		}},
+	}
+}
+
+// immutableDeepTagValidator is +k8s:immutableDeep: it shares every semantic
+// of +k8s:immutable (unset/set/modify rules, zero-default handling,
+// subresource relaxation) but always compares via equality.Semantic.DeepEqual
+// (ImmutableByReflect) instead of native ==, even on types the generator
+// could otherwise prove directly comparable. Useful for a comparable struct
+// that itself holds a pointer field: native == on such a struct compares the
+// pointer's address, not the pointed-to value, which is rarely the intended
+// "did this field change" semantics.
+type immutableDeepTagValidator struct{}
+
+func (immutableDeepTagValidator) Init(_ Config) {}
+
+func (immutableDeepTagValidator) TagName() string {
+	return immutableDeepTagName
+}
+
+func (immutableDeepTagValidator) ValidScopes() sets.Set[Scope] {
+	return immutableTagValidScopes
+}
+
+func (idtv immutableDeepTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	return immutableValidations(immutableDeepTagName, context, tag, true)
+}
+
+func (idtv immutableDeepTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    idtv.TagName(),
+		Scopes: idtv.ValidScopes().UnsortedList(),
+		Description: "Like +k8s:immutable, but always compares old and new values by deep/semantic" +
+			" equality rather than by native ==, even when the field's type is directly comparable." +
+			" For types that aren't directly comparable anyway, this behaves identically to +k8s:immutable." +
+			" See +k8s:immutable for the unset/set/modify semantics and subresource relaxation args.",
+		Args: []TagArgDoc{{
+			Description: `exceptSubresources=[...]: a JSON array holding at most one subresource name that this validation does not apply to (default: ["status"])`,
+			Type:        codetags.ArgTypeString,
+		}, {
+			Description: `onSubresources=[...]: a JSON array holding exactly one subresource name that this validation applies to exclusively`,
+			Type:        codetags.ArgTypeString,
+		}},
+	}
+}
+
+// immutableValidations backs both +k8s:immutable and +k8s:immutableDeep;
+// forceReflect selects ImmutableByReflect (deep/semantic equality)
+// unconditionally instead of letting IsDirectComparable choose the faster
+// native-== path.
+func immutableValidations(tagName string, context Context, tag codetags.Tag, forceReflect bool) (Validations, error) {
 	var result Validations
 
 	// If validating a field, check for default value.
@@ -106,34 +200,89 @@ func (itv immutableTagValidator) GetValidations(context Context, _ codetags.Tag)
 		}
 	}
 
-	if !util.IsDirectComparable(util.NonPointer(util.NativeType(context.Type))) {
-		result.AddFunction(Function(immutableTagName, DefaultFlags, immutableReflectValidator))
-		return result, nil
-	}
-
-	isPointerField := false
-	if context.Member != nil {
-		memberType := context.Member.Type
-		if memberType != nil && memberType.Kind == types.Pointer {
+	var fn FunctionGen
+	if forceReflect || !util.IsDirectComparable(util.NonPointer(util.NativeType(context.Type))) {
+		fn = Function(tagName, DefaultFlags, immutableReflectValidator)
+	} else {
+		isPointerField := false
+		if context.Member != nil {
+			memberType := context.Member.Type
+			if memberType != nil && memberType.Kind == types.Pointer {
+				isPointerField = true
+			}
+		} else if util.NativeType(context.Type).Kind == types.Pointer {
 			isPointerField = true
 		}
-	} else if util.NativeType(context.Type).Kind == types.Pointer {
-		isPointerField = true
+
+		if isPointerField {
+			fn = Function(tagName, DefaultFlags, immutablePointerByCompareValidator)
+		} else {
+			fn = Function(tagName, DefaultFlags, immutableValueByCompareValidator)
+		}
 	}
 
-	if isPointerField {
-		result.AddFunction(Function(immutableTagName, DefaultFlags, immutablePointerByCompareValidator))
-	} else {
-		result.AddFunction(Function(immutableTagName, DefaultFlags, immutableValueByCompareValidator))
+	cond, apply, err := subresourceRelaxationArgs(tagName, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	if apply {
+		fn = fn.WithConditions(cond)
 	}
 
+	result.AddFunction(fn)
 	return result, nil
 }
 
-func (itv immutableTagValidator) Docs() TagDoc {
-	return TagDoc{
-		Tag:         itv.TagName(),
-		Scopes:      itv.ValidScopes().UnsortedList(),
-		Description: "Indicates that a field can be set once (now or at creation), then becomes immutable. Allows transition from unset to set, but forbids modify or clear operations. Fields with default values are considered already set.",
+// subresourceRelaxationArgs parses the exceptSubresources/onSubresources
+// named arguments shared by +k8s:immutable and +k8s:frozen, returning the
+// Conditions to attach to the emitted validator function (and apply=false
+// if no Conditions should be attached at all). Defaults to excluding the
+// "status" subresource, since the most common reason to relax an otherwise
+// immutable/frozen field is a controller that must still write status.
+// Only a single subresource name is supported in either argument: Conditions
+// holds one IsSubresource/IsNotSubresource string, not a list, so there is
+// no way to express "except A and B" without running the underlying
+// validator function more than once per update.
+func subresourceRelaxationArgs(tagName string, tag codetags.Tag) (cond Conditions, apply bool, err error) {
+	except := "status"
+	on := ""
+	sawOn := false
+
+	for _, arg := range tag.Args {
+		switch arg.Name {
+		case "exceptSubresources":
+			var names []string
+			if err := json.Unmarshal([]byte(arg.Value), &names); err != nil {
+				return Conditions{}, false, fmt.Errorf("%s: exceptSubresources must be a JSON array of strings: %w", tagName, err)
+			}
+			switch len(names) {
+			case 0:
+				except = ""
+			case 1:
+				except = names[0]
+			default:
+				return Conditions{}, false, fmt.Errorf("%s: exceptSubresources only supports a single subresource name, got %v", tagName, names)
+			}
+		case "onSubresources":
+			var names []string
+			if err := json.Unmarshal([]byte(arg.Value), &names); err != nil {
+				return Conditions{}, false, fmt.Errorf("%s: onSubresources must be a JSON array of strings: %w", tagName, err)
+			}
+			if len(names) != 1 {
+				return Conditions{}, false, fmt.Errorf("%s: onSubresources only supports a single subresource name, got %v", tagName, names)
+			}
+			on, sawOn = names[0], true
+			except = ""
+		default:
+			return Conditions{}, false, fmt.Errorf("%s: unsupported argument %q", tagName, arg.Name)
+		}
+	}
+
+	if sawOn {
+		return Conditions{IsSubresource: on}, true, nil
+	}
+	if except != "" {
+		return Conditions{IsNotSubresource: except}, true, nil
 	}
+	return Conditions{}, false, nil
 }
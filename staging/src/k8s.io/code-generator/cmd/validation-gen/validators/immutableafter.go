@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/types"
+)
+
+const immutableAfterTagName = "k8s:immutableAfter"
+
+func init() {
+	RegisterTagValidator(immutableAfterTagValidator{})
+}
+
+// immutableAfterTagValidator implements
+// +k8s:immutableAfter=<siblingField>=<value>: a field is free to change
+// until a sibling field, evaluated on the old object, reaches the given
+// value, after which the field is immutable exactly like +k8s:immutable.
+// The sibling is resolved with the same FieldReference mechanism
+// +k8s:lessThan and +k8s:sumEquals use, but unlike those tags the reference
+// is always read from the old object, since "after" is inherently a
+// statement about prior state (e.g. a PersistentVolumeClaim's spec once
+// status.phase was already Bound, or a Pod's nodeName once it was already
+// scheduled).
+type immutableAfterTagValidator struct{}
+
+func (immutableAfterTagValidator) Init(_ Config) {}
+
+func (immutableAfterTagValidator) TagName() string {
+	return immutableAfterTagName
+}
+
+var immutableAfterTagValidScopes = sets.New(ScopeField, ScopeMapVal, ScopeListVal)
+
+func (immutableAfterTagValidator) ValidScopes() sets.Set[Scope] {
+	return immutableAfterTagValidScopes
+}
+
+var (
+	immutableAfterValueByCompareValidator   = types.Name{Package: libValidationPkg, Name: "ImmutableValueAfterByCompare"}
+	immutableAfterPointerByCompareValidator = types.Name{Package: libValidationPkg, Name: "ImmutablePointerAfterByCompare"}
+	immutableAfterReflectValidator          = types.Name{Package: libValidationPkg, Name: "ImmutableAfterByReflect"}
+)
+
+func (immutableAfterTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
+	var result Validations
+
+	parts := strings.SplitN(payload, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return result, fmt.Errorf("%s: payload must have the form <field name>=<value>, got %q", immutableAfterTagName, payload)
+	}
+	triggerName, wantTrigger := parts[0], parts[1]
+
+	trigger, ok, err := FieldReference(context, triggerName)
+	if err != nil {
+		return result, err
+	}
+	if !ok {
+		return result, fmt.Errorf("%s: must reference another field by its JSON name, got %q", immutableAfterTagName, triggerName)
+	}
+
+	if !util.IsDirectComparable(util.NonPointer(util.NativeType(context.Type))) {
+		result.AddFunction(Function(immutableAfterTagName, DefaultFlags, immutableAfterReflectValidator, trigger, wantTrigger, trigger.FieldName))
+		return result, nil
+	}
+
+	isPointerField := false
+	if context.Member != nil {
+		memberType := context.Member.Type
+		if memberType != nil && memberType.Kind == types.Pointer {
+			isPointerField = true
+		}
+	} else if util.NativeType(context.Type).Kind == types.Pointer {
+		isPointerField = true
+	}
+
+	if isPointerField {
+		result.AddFunction(Function(immutableAfterTagName, DefaultFlags, immutableAfterPointerByCompareValidator, trigger, wantTrigger, trigger.FieldName))
+	} else {
+		result.AddFunction(Function(immutableAfterTagName, DefaultFlags, immutableAfterValueByCompareValidator, trigger, wantTrigger, trigger.FieldName))
+	}
+
+	return result, nil
+}
+
+func (v immutableAfterTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    v.TagName(),
+		Scopes: v.ValidScopes().UnsortedList(),
+		Description: "Indicates that a field can change freely until a sibling field, evaluated on the old object," +
+			" reaches the given value, after which the field becomes immutable exactly like +k8s:immutable." +
+			" The sibling field must be a string on the old object; only string-valued trigger fields are supported.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<field name>=<value>",
+			Docs:        "Once the named sibling field's old value equals <value>, this field can no longer be changed.",
+		}},
+		Usage: `+k8s:immutableAfter=phase=Bound`,
+	}
+}
@@ -61,25 +61,24 @@ var (
 )
 
 func (stv *itemTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
-	matcherPairs, elemT, err := stv.validateAndParseTag(context, tag)
+	pred, pathText, elemT, err := stv.validateAndParseTag(context, tag)
 	if err != nil {
 		return Validations{}, err
 	}
 
-	// Generates context path like Struct.Conditions[status="true",type="Approved"].
-	subContextPath := generatePathForMap(matcherPairs)
-
 	result := Validations{}
 	subContext := Context{
 		Scope:  ScopeField,
 		Type:   elemT,
 		Parent: context.Parent,
-		Path:   context.Path.Key(subContextPath),
+		// Generates context path like Struct.Conditions[status="true",type="Approved"].
+		Path:   context.Path.Key(pathText),
 		Member: context.Member,
 		VirtualField: &ItemExtractorField{
 			listFieldName: context.Member.Name,
 			elemType:      elemT,
-			matcherPairs:  matcherPairs,
+			pred:          pred,
+			pathText:      pathText,
 		},
 	}
 
@@ -95,7 +94,7 @@ func (stv *itemTagValidator) GetValidations(context Context, tag codetags.Tag) (
 		}
 	}
 
-	matchFn, err := createMatchFn(elemT, matcherPairs)
+	matchFn, err := createItemMatchFn(elemT, pred)
 	if err != nil {
 		return Validations{}, err
 	}
@@ -115,24 +114,49 @@ func (stv *itemTagValidator) GetValidations(context Context, tag codetags.Tag) (
 	return result, nil
 }
 
-// validateAndParseTag validates the tag arguments and context, returning the matcher pairs and element type
-func (stv *itemTagValidator) validateAndParseTag(context Context, tag codetags.Tag) ([][2]string, *types.Type, error) {
-	matcherPairs := [][2]string{}
-	processedKeys := sets.NewString()
-
-	for _, arg := range tag.Args {
-		if arg.Name == "" {
-			return nil, nil, fmt.Errorf("all arguments must be named (e.g., fieldName:\"value\")")
+// validateAndParseTag validates the tag arguments and context, returning the
+// parsed match predicate, its rendered path-segment text, and the list's
+// element type. Arguments may either be a flat list of named key:"value"
+// pairs (the classic form, matched as an implicit AND of equalities), or a
+// single `where: "<predicate>"` argument (see parsePredicate) -- the two
+// forms are mutually exclusive.
+func (stv *itemTagValidator) validateAndParseTag(context Context, tag codetags.Tag) (predNode, string, *types.Type, error) {
+	var pred predNode
+	var pathText string
+	var keyFieldNames []string // JSON field names this predicate pins to a literal, for the listMapKey-coverage check below
+
+	if where, isWhere, err := itemWhereArg(tag.Args); err != nil {
+		return nil, "", nil, err
+	} else if isWhere {
+		parsed, err := parsePredicate(where)
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("invalid where predicate: %w", err)
 		}
-		if processedKeys.Has(arg.Name) {
-			return nil, nil, fmt.Errorf("duplicate key %q in item", arg.Name)
+		pred = parsed
+		frontier := predEqualityFrontier(parsed)
+		for _, cmp := range frontier {
+			keyFieldNames = append(keyFieldNames, cmp.field)
 		}
-		processedKeys.Insert(arg.Name)
-		matcherPairs = append(matcherPairs, [2]string{arg.Name, arg.Value})
-	}
-
-	if len(matcherPairs) == 0 {
-		return nil, nil, fmt.Errorf("item requires at least one key-value pair")
+		pathText = generatePathForPredicate(frontier)
+	} else {
+		matcherPairs := [][2]string{}
+		processedKeys := sets.NewString()
+		for _, arg := range tag.Args {
+			if arg.Name == "" {
+				return nil, "", nil, fmt.Errorf("all arguments must be named (e.g., fieldName:\"value\")")
+			}
+			if processedKeys.Has(arg.Name) {
+				return nil, "", nil, fmt.Errorf("duplicate key %q in item", arg.Name)
+			}
+			processedKeys.Insert(arg.Name)
+			matcherPairs = append(matcherPairs, [2]string{arg.Name, arg.Value})
+			keyFieldNames = append(keyFieldNames, arg.Name)
+		}
+		if len(matcherPairs) == 0 {
+			return nil, "", nil, fmt.Errorf("item requires at least one key-value pair")
+		}
+		pred = pairsToPredicate(matcherPairs)
+		pathText = generatePathForMap(matcherPairs)
 	}
 
 	// This tag can apply to value and pointer fields, as well as typedefs
@@ -140,29 +164,30 @@ func (stv *itemTagValidator) validateAndParseTag(context Context, tag codetags.T
 	t := util.NonPointer(util.NativeType(context.Type))
 
 	if t.Kind != types.Slice {
-		return nil, nil, fmt.Errorf("can only be used on list types")
+		return nil, "", nil, fmt.Errorf("can only be used on list types")
 	}
 
 	elemT := util.NonPointer(util.NativeType(t.Elem))
 	if elemT.Kind != types.Struct {
-		return nil, nil, fmt.Errorf("can only be used on list of structs")
+		return nil, "", nil, fmt.Errorf("can only be used on list of structs")
 	}
 
 	if context.Member == nil {
-		return nil, nil, fmt.Errorf("unexpected nil context member")
+		return nil, "", nil, fmt.Errorf("unexpected nil context member")
 	}
 
 	// Ensure the field is a list-map.
 	listMap, found := stv.byFieldPath[context.Path.String()]
 	if !found || !listMap.declaredAsMap || len(listMap.keyFields) == 0 {
-		return nil, nil, fmt.Errorf("must have +k8s:listType=map and at least one '+k8s:listMapKey=...' annotation to use +k8s:item")
+		return nil, "", nil, fmt.Errorf("must have +k8s:listType=map and at least one '+k8s:listMapKey=...' annotation to use +k8s:item")
 	}
 
-	// Ensure all defined listMapKeys are provided in the tag.
+	// Ensure all defined listMapKeys are pinned to a literal at the
+	// predicate's top-level conjunctive frontier.
 	foundRequiredKeys := 0
 	for _, fieldName := range listMap.keyFields {
-		for _, pair := range matcherPairs {
-			if member := util.GetMemberByJSON(elemT, pair[0]); member != nil && member.Name == fieldName {
+		for _, jsonName := range keyFieldNames {
+			if member := util.GetMemberByJSON(elemT, jsonName); member != nil && member.Name == fieldName {
 				foundRequiredKeys++
 				break
 			}
@@ -170,34 +195,55 @@ func (stv *itemTagValidator) validateAndParseTag(context Context, tag codetags.T
 	}
 
 	if foundRequiredKeys != len(listMap.keyFields) {
-		return nil, nil, fmt.Errorf("item field-value pairs must include all +k8s:listMapKey fields (expected: %v)", listMap.keyFields)
+		return nil, "", nil, fmt.Errorf("item field-value pairs must include all +k8s:listMapKey fields (expected: %v)", listMap.keyFields)
 	}
 
-	for _, pair := range matcherPairs {
-		if util.GetMemberByJSON(elemT, pair[0]) == nil {
-			return nil, nil, fmt.Errorf("list item has no field with JSON name %q", pair[0])
+	for _, jsonName := range keyFieldNames {
+		if util.GetMemberByJSON(elemT, jsonName) == nil {
+			return nil, "", nil, fmt.Errorf("list item has no field with JSON name %q", jsonName)
 		}
 	}
 
 	if tag.ValueType != codetags.ValueTypeTag {
-		return nil, nil, fmt.Errorf("item requires a validation tag as its value payload")
+		return nil, "", nil, fmt.Errorf("item requires a validation tag as its value payload")
 	}
 
 	if tag.ValueTag == nil {
-		return nil, nil, fmt.Errorf("item requires a non-nil validation tag as its value payload")
+		return nil, "", nil, fmt.Errorf("item requires a non-nil validation tag as its value payload")
 	}
 
-	return matcherPairs, elemT, nil
+	return pred, pathText, elemT, nil
+}
+
+// itemWhereArg reports whether tag's arguments are the single-argument
+// `where: "<predicate>"` form, returning its predicate text. It is an error
+// to combine `where` with any other argument.
+func itemWhereArg(args []codetags.Arg) (string, bool, error) {
+	hasWhere := false
+	for _, arg := range args {
+		if arg.Name == "where" {
+			hasWhere = true
+			break
+		}
+	}
+	if !hasWhere {
+		return "", false, nil
+	}
+	if len(args) != 1 {
+		return "", false, fmt.Errorf("where cannot be combined with other arguments")
+	}
+	return args[0].Value, true, nil
 }
 
 type ItemExtractorField struct {
 	listFieldName string
 	elemType      *types.Type
-	matcherPairs  [][2]string
+	pred          predNode
+	pathText      string
 }
 
 func (lef *ItemExtractorField) ID() string {
-	return fmt.Sprintf("%s[%s]", lef.listFieldName, generatePathForMap(lef.matcherPairs))
+	return fmt.Sprintf("%s[%s]", lef.listFieldName, lef.pathText)
 }
 
 func (lef *ItemExtractorField) Type() *types.Type {
@@ -206,10 +252,11 @@ func (lef *ItemExtractorField) Type() *types.Type {
 
 // GenerateExtractor creates an extractor function for the parent type
 func (lef *ItemExtractorField) GenerateExtractor(parentType *types.Type) FunctionLiteral {
-	var conditions []string
-	for _, pair := range lef.matcherPairs {
-		member := util.GetMemberByJSON(lef.elemType, pair[0])
-		conditions = append(conditions, fmt.Sprintf("item.%s == %q", member.Name, pair[1]))
+	condition, err := predicateExpr(lef.elemType, lef.pred, "item")
+	if err != nil {
+		// lef.pred was already validated (and rendered by createItemMatchFn)
+		// by the time an extractor is generated from it, so this can't happen.
+		panic(err)
 	}
 
 	extractorCode := fmt.Sprintf(`func() interface{} {
@@ -221,7 +268,7 @@ func (lef *ItemExtractorField) GenerateExtractor(parentType *types.Type) Functio
 			}
 		}
 		return false
-	}()`, lef.listFieldName, lef.listFieldName, strings.Join(conditions, " && "))
+	}()`, lef.listFieldName, lef.listFieldName, condition)
 
 	return FunctionLiteral{
 		Parameters: []ParamResult{{Name: "obj", Type: types.PointerTo(parentType)}},
@@ -230,29 +277,18 @@ func (lef *ItemExtractorField) GenerateExtractor(parentType *types.Type) Functio
 	}
 }
 
-func createMatchFn(elemT *types.Type, matcherPairs [][2]string) (FunctionLiteral, error) {
-	var matchFuncBody strings.Builder
-	matchFuncBody.WriteString("if item == nil { return false }\n")
-
-	var conditions []string
-
-	for _, pair := range matcherPairs {
-		jsonKey := pair[0]
-		value := pair[1]
-		member := util.GetMemberByJSON(elemT, jsonKey)
-
-		if util.NativeType(member.Type).Kind != types.Builtin || util.NativeType(member.Type) != types.String {
-			return FunctionLiteral{}, fmt.Errorf("key field %q for item must be of type string or an alias to string, got %s", member.Name, member.Type.String())
-		}
-		condition := fmt.Sprintf("item.%s == %q", member.Name, value)
-		conditions = append(conditions, condition)
+// createItemMatchFn compiles pred into the FunctionLiteral body of a
+// `func(item *elemT) bool` match function.
+func createItemMatchFn(elemT *types.Type, pred predNode) (FunctionLiteral, error) {
+	condition, err := predicateExpr(elemT, pred, "item")
+	if err != nil {
+		return FunctionLiteral{}, err
 	}
-
-	matchFuncBody.WriteString(fmt.Sprintf("return %s", strings.Join(conditions, " && ")))
+	body := fmt.Sprintf("if item == nil { return false }\nreturn %s", condition)
 	return FunctionLiteral{
 		Parameters: []ParamResult{{"item", types.PointerTo(elemT)}},
 		Results:    []ParamResult{{"", types.Bool}},
-		Body:       matchFuncBody.String(),
+		Body:       body,
 	}, nil
 }
 
@@ -273,10 +309,17 @@ func (stv itemTagValidator) Docs() TagDoc {
 		Tag:    stv.TagName(),
 		Scopes: stv.ValidScopes().UnsortedList(),
 		Description: "Declares a validation for an item of a slice declared as a +k8s:listType=map. " +
-			"The item to match is declared by providing field-value pair arguments. All +k8s:listMapKey=... fields must be included in the field-value pair arguments.",
-		Usage: "+k8s:item(key: value)=<validation-tag>",
+			"The item to match is declared either by providing field-value pair arguments, or a single `where: \"<predicate>\"` " +
+			"argument. All +k8s:listMapKey=... fields must be pinned to a literal value by the match, whichever form is used.",
+		Usage: "+k8s:item(key: value)=<validation-tag> or +k8s:item(where: \"<predicate>\")=<validation-tag>",
 		Docs: "Arguments must be named with the JSON names of the list map key fields. " +
-			"For example, if the list has +k8s:listMapKey=name, use: +k8s:item(name: myname)=+k8s:immutable",
+			"For example, if the list has +k8s:listMapKey=name, use: +k8s:item(name: myname)=+k8s:immutable\n" +
+			"Alternatively, `where` accepts a small boolean predicate over the element's fields: the operators " +
+			"==, !=, <, <=, >, >=, &&, ||, ! and parentheses, with quoted-string, integer, and true/false literals, " +
+			"e.g. +k8s:item(where: \"type == \\\"Ready\\\" && observedGeneration > 0\")=+k8s:required. Every " +
+			"+k8s:listMapKey=... field must appear as an == comparison at the predicate's top-level `&&` frontier " +
+			"(not inside an `||` or behind a `!`), so the matched item's key fields are always pinned regardless of " +
+			"how the rest of the predicate evaluates.",
 		AcceptsUnknownArgs: true,
 		Payloads: []TagPayloadDoc{{
 			Description: "<validation-tag>",
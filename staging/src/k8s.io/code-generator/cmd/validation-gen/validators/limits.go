@@ -27,16 +27,30 @@ import (
 const (
 	maxLengthTagName = "k8s:maxLength"
 	maxItemsTagName  = "k8s:maxItems"
-	minimumTagName   = "k8s:minimum"
-	maximumTagName   = "k8s:maximum"
+
+	minimumTagName          = "k8s:minimum"
+	maximumTagName          = "k8s:maximum"
+	exclusiveMinimumTagName = "k8s:exclusiveMinimum"
+	exclusiveMaximumTagName = "k8s:exclusiveMaximum"
+	multipleOfTagName       = "k8s:multipleOf"
+
+	// exclusiveArg is the args[] value that toggles minimumTagValidator and
+	// maximumTagValidator from their inclusive (>=, <=) comparison to the
+	// strict (>, <) one, i.e. the same comparison exclusiveMinimumTagName and
+	// exclusiveMaximumTagName always use.
+	exclusiveArg = "exclusive"
 )
 
 func init() {
 	RegisterTagValidator(maxLengthTagValidator{})
 	RegisterTagValidator(maxItemsTagValidator{})
 
-	RegisterTagValidator(minimumTagValidator{})
-	RegisterTagValidator(maximumTagValidator{})
+	RegisterTagValidator(minMaxTagValidator{tagName: minimumTagName, greater: true})
+	RegisterTagValidator(minMaxTagValidator{tagName: maximumTagName, greater: false})
+	RegisterTagValidator(minMaxTagValidator{tagName: exclusiveMinimumTagName, greater: true, exclusive: true})
+	RegisterTagValidator(minMaxTagValidator{tagName: exclusiveMaximumTagName, greater: false, exclusive: true})
+
+	RegisterTagValidator(multipleOfTagValidator{})
 }
 
 type refLimit struct {
@@ -148,123 +162,209 @@ func (mitv maxItemsTagValidator) Docs() TagDoc {
 	}
 }
 
-type minimumTagValidator struct{}
+// quantityTypeName identifies k8s.io/apimachinery/pkg/api/resource.Quantity,
+// the one non-numeric-kind Go type that minMaxTagValidator and
+// multipleOfTagValidator special-case.
+var quantityTypeName = types.Name{Package: "k8s.io/apimachinery/pkg/api/resource", Name: "Quantity"}
 
-func (minimumTagValidator) Init(_ Config) {}
+// isQuantityType reports whether t is resource.Quantity.
+func isQuantityType(t *types.Type) bool {
+	return t.Name == quantityTypeName
+}
 
-func (minimumTagValidator) TagName() string {
-	return minimumTagName
+// minMaxTagValidator implements k8s:minimum, k8s:maximum, k8s:exclusiveMinimum,
+// and k8s:exclusiveMaximum. greater selects whether the field's value must be
+// bounded from below (minimum-style) or from above (maximum-style);
+// exclusive selects a strict (>, <) comparison over an inclusive (>=, <=)
+// one. A single minimum/maximum instance can also be switched to its
+// exclusive variant via args (see exclusiveArg), so that the same tag can
+// drive either comparison.
+type minMaxTagValidator struct {
+	tagName   string
+	greater   bool
+	exclusive bool
 }
 
-var minimumTagValidScopes = sets.New(
-	ScopeAny,
-)
+func (minMaxTagValidator) Init(_ Config) {}
 
-func (minimumTagValidator) ValidScopes() sets.Set[Scope] {
-	return minimumTagValidScopes
+func (mtv minMaxTagValidator) TagName() string {
+	return mtv.tagName
 }
 
-var (
-	minimumValidator      = types.Name{Package: libValidationPkg, Name: "Minimum"}
-	minimumFieldValidator = types.Name{Package: libValidationPkg, Name: "MinimumField"}
-)
+var minMaxTagValidScopes = sets.New(ScopeAny)
+
+func (minMaxTagValidator) ValidScopes() sets.Set[Scope] {
+	return minMaxTagValidScopes
+}
+
+// names returns the library function names for this validator's
+// greater/exclusive combination: one for integer fields, one for float
+// fields, one for resource.Quantity fields, and one for cross-field
+// (+k8s:minimum=<otherField>) references.
+func (mtv minMaxTagValidator) names() (scalar, float, quantity, field types.Name) {
+	base := "Minimum"
+	if !mtv.greater {
+		base = "Maximum"
+	}
+	if mtv.exclusive {
+		base = "Exclusive" + base
+	}
+	return types.Name{Package: libValidationPkg, Name: base},
+		types.Name{Package: libValidationPkg, Name: base + "Float"},
+		types.Name{Package: libValidationPkg, Name: base + "Quantity"},
+		types.Name{Package: libValidationPkg, Name: base + "Field"}
+}
 
-func (mtv minimumTagValidator) GetValidations(context Context, args []string, payload string) (Validations, error) {
+func (mtv minMaxTagValidator) GetValidations(context Context, args []string, payload string) (Validations, error) {
 	var result Validations
 
+	scalarFn, floatFn, quantityFn, fieldFn := mtv.names()
+
 	if field, ok, err := FieldReference(context, payload); ok {
 		if err != nil {
 			return result, err
 		}
-		vfn := Function(minimumTagName, DefaultFlags, minimumFieldValidator, field, field.FieldName)
+		vfn := Function(mtv.tagName, DefaultFlags, fieldFn, field, field.FieldName)
 		result.Functions = append(result.Functions, vfn)
 		return result, nil
 	}
 
-	// This tag can apply to value and pointer fields, as well as typedefs
-	// (which should never be pointers). We need to check the concrete type.
-	if t := nonPointer(nativeType(context.Type)); !types.IsInteger(t) {
-		return result, fmt.Errorf("can only be used on integer types (%s)", rootTypeString(context.Type, t))
+	exclusive := mtv.exclusive
+	for _, arg := range args {
+		if arg == exclusiveArg {
+			exclusive = true
+		}
 	}
-
-	if len(args) == 1 {
-		// TODO
-		panic("not implemented")
+	if exclusive != mtv.exclusive {
+		scalarFn, floatFn, quantityFn, _ = (minMaxTagValidator{tagName: mtv.tagName, greater: mtv.greater, exclusive: exclusive}).names()
 	}
 
-	intVal, err := strconv.Atoi(payload)
-	if err != nil {
-		return result, fmt.Errorf("failed to parse tag payload as int: %w", err)
+	// This tag can apply to value and pointer fields, as well as typedefs
+	// (which should never be pointers). We need to check the concrete type.
+	t := nonPointer(nativeType(context.Type))
+	switch {
+	case types.IsInteger(t):
+		intVal, err := strconv.Atoi(payload)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse tag payload as int: %w", err)
+		}
+		result.AddFunction(Function(mtv.tagName, DefaultFlags, scalarFn, intVal))
+	case t == types.Float32 || t == types.Float64:
+		floatVal, err := strconv.ParseFloat(payload, 64)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse tag payload as float: %w", err)
+		}
+		result.AddFunction(Function(mtv.tagName, DefaultFlags, floatFn, floatVal))
+	case isQuantityType(t):
+		floatVal, err := strconv.ParseFloat(payload, 64)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse tag payload as float: %w", err)
+		}
+		result.AddFunction(Function(mtv.tagName, DefaultFlags, quantityFn, floatVal))
+	default:
+		return result, fmt.Errorf("can only be used on integer, float, or resource.Quantity types (%s)", rootTypeString(context.Type, t))
 	}
-	result.AddFunction(Function(minimumTagName, DefaultFlags, minimumValidator, intVal))
 	return result, nil
 }
 
-func (mtv minimumTagValidator) Docs() TagDoc {
-	return TagDoc{
-		Tag:         mtv.TagName(),
-		Scopes:      mtv.ValidScopes().UnsortedList(),
-		Description: "Indicates that a numeric field has a minimum value.",
+func (mtv minMaxTagValidator) Docs() TagDoc {
+	verb := "greater than or equal to"
+	switch {
+	case mtv.greater && mtv.exclusive:
+		verb = "greater than"
+	case !mtv.greater && !mtv.exclusive:
+		verb = "less than or equal to"
+	case !mtv.greater && mtv.exclusive:
+		verb = "less than"
+	}
+	doc := TagDoc{
+		Tag:    mtv.tagName,
+		Scopes: mtv.ValidScopes().UnsortedList(),
+		Description: fmt.Sprintf("Indicates that a numeric field must be %s x. Supports integer, float, and"+
+			" resource.Quantity fields, as well as a reference to a sibling field.", verb),
 		Payloads: []TagPayloadDoc{{
-			Description: "<integer>",
-			Docs:        "This field must be greater than or equal to x.",
+			Description: "<number>",
+			Docs:        fmt.Sprintf("This field must be %s x.", verb),
+		}, {
+			Description: "<field name>",
+			Docs:        fmt.Sprintf("This field must be %s the named sibling field's value.", verb),
 		}},
 	}
+	if !mtv.exclusive {
+		doc.Args = []TagArgDoc{{
+			Description: fmt.Sprintf("%s: use a strict (%s) comparison instead of the default inclusive one", exclusiveArg, map[bool]string{true: ">", false: "<"}[mtv.greater]),
+		}}
+	}
+	return doc
 }
 
-type maximumTagValidator struct{}
+type multipleOfTagValidator struct{}
 
-func (maximumTagValidator) Init(_ Config) {}
+func (multipleOfTagValidator) Init(_ Config) {}
 
-func (maximumTagValidator) TagName() string {
-	return maximumTagName
+func (multipleOfTagValidator) TagName() string {
+	return multipleOfTagName
 }
 
-var maximumTagValidScopes = sets.New(
-	ScopeAny,
-)
+var multipleOfTagValidScopes = sets.New(ScopeAny)
 
-func (maximumTagValidator) ValidScopes() sets.Set[Scope] {
-	return maximumTagValidScopes
+func (multipleOfTagValidator) ValidScopes() sets.Set[Scope] {
+	return multipleOfTagValidScopes
 }
 
 var (
-	maximumValidator      = types.Name{Package: libValidationPkg, Name: "Maximum"}
-	maximumFieldValidator = types.Name{Package: libValidationPkg, Name: "MaximumField"}
+	multipleOfValidator         = types.Name{Package: libValidationPkg, Name: "MultipleOf"}
+	multipleOfFloatValidator    = types.Name{Package: libValidationPkg, Name: "MultipleOfFloat"}
+	multipleOfQuantityValidator = types.Name{Package: libValidationPkg, Name: "MultipleOfQuantity"}
 )
 
-func (mtv maximumTagValidator) GetValidations(context Context, args []string, payload string) (Validations, error) {
+func (multipleOfTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
 	var result Validations
 
-	if field, ok, err := FieldReference(context, payload); ok {
+	t := nonPointer(nativeType(context.Type))
+	switch {
+	case types.IsInteger(t):
+		intVal, err := strconv.Atoi(payload)
 		if err != nil {
-			return result, err
+			return result, fmt.Errorf("failed to parse tag payload as int: %w", err)
 		}
-		vfn := Function(maximumTagName, DefaultFlags, maximumFieldValidator, field, field.FieldName)
-		result.Functions = append(result.Functions, vfn)
-		return result, nil
-	}
-
-	if t := nonPointer(nativeType(context.Type)); !types.IsInteger(t) {
-		return result, fmt.Errorf("can only be used on integer types (%s)", rootTypeString(context.Type, t))
-	}
-
-	intVal, err := strconv.Atoi(payload)
-	if err != nil {
-		return result, fmt.Errorf("failed to parse tag payload as int: %w", err)
+		if intVal == 0 {
+			return result, fmt.Errorf("must not be zero")
+		}
+		result.AddFunction(Function(multipleOfTagName, DefaultFlags, multipleOfValidator, intVal))
+	case t == types.Float32 || t == types.Float64:
+		floatVal, err := strconv.ParseFloat(payload, 64)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse tag payload as float: %w", err)
+		}
+		if floatVal == 0 {
+			return result, fmt.Errorf("must not be zero")
+		}
+		result.AddFunction(Function(multipleOfTagName, DefaultFlags, multipleOfFloatValidator, floatVal))
+	case isQuantityType(t):
+		floatVal, err := strconv.ParseFloat(payload, 64)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse tag payload as float: %w", err)
+		}
+		if floatVal == 0 {
+			return result, fmt.Errorf("must not be zero")
+		}
+		result.AddFunction(Function(multipleOfTagName, DefaultFlags, multipleOfQuantityValidator, floatVal))
+	default:
+		return result, fmt.Errorf("can only be used on integer, float, or resource.Quantity types (%s)", rootTypeString(context.Type, t))
 	}
-	result.AddFunction(Function(maximumTagName, DefaultFlags, maximumValidator, intVal))
 	return result, nil
 }
 
-func (mtv maximumTagValidator) Docs() TagDoc {
+func (motv multipleOfTagValidator) Docs() TagDoc {
 	return TagDoc{
-		Tag:         mtv.TagName(),
-		Scopes:      mtv.ValidScopes().UnsortedList(),
-		Description: "Indicates that a numeric field has a maximum value.",
+		Tag:         motv.TagName(),
+		Scopes:      motv.ValidScopes().UnsortedList(),
+		Description: "Indicates that a numeric field must be an exact multiple of x.",
 		Payloads: []TagPayloadDoc{{
-			Description: "<integer>",
-			Docs:        "This field must be less than or equal to x.",
+			Description: "<number>",
+			Docs: "This field must be evenly divisible by x. Integer fields use exact modulus;" +
+				" float and resource.Quantity fields use a small tolerance to absorb floating point rounding.",
 		}},
 	}
 }
@@ -19,10 +19,13 @@ package validators
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
 	"k8s.io/gengo/v2/types"
 )
 
@@ -42,6 +45,26 @@ func (stv *listMapItemTagValidator) Init(cfg Config) {
 	stv.validator = cfg.Validator
 }
 
+// listMapKeySMDPathFormat switches the key text +k8s:listMapItem and
+// +k8s:listMapItems put in the generator's Context.Path and synthetic member
+// name for a matched list-map item from the legacy "key=value,..." form to
+// the structured-merge-diff PathElement.Key-compatible "k:{...}" form. It
+// defaults to the legacy form; callers that want the structured-merge-diff
+// form must call SetListMapKeySMDPathFormat before the generator runs. It
+// does not change the field paths of runtime validation errors: those index
+// the list by position for +k8s:listMapItem and use the "Field=value,..."
+// key-field text for +k8s:listMapItems.
+var listMapKeySMDPathFormat = false
+
+// SetListMapKeySMDPathFormat configures whether +k8s:listMapItem and
+// +k8s:listMapItems key matched items in the generator's Context.Path and
+// synthetic member name in the structured-merge-diff
+// PathElement.Key-compatible "k:{...}" form (enabled) or the legacy
+// "key=value,..." form (disabled, the default).
+func SetListMapKeySMDPathFormat(enabled bool) {
+	listMapKeySMDPathFormat = enabled
+}
+
 func (listMapItemTagValidator) TagName() string {
 	return listMapItemTagName
 }
@@ -55,17 +78,37 @@ func (listMapItemTagValidator) ValidScopes() sets.Set[Scope] {
 // LateTagValidator ensures this runs after listMapKey tags are processed
 func (listMapItemTagValidator) LateTagValidator() {}
 
+// listMapItemMatcher is one key=value comparison in a parsed +k8s:listMapItem
+// selector. Value holds the raw JSON scalar the selector supplied for this
+// key -- a string, a float64 (JSON numbers), or a bool -- so createMatchFn
+// can render the right kind of Go literal for the key field's actual type.
+type listMapItemMatcher struct {
+	Key   string
+	Value interface{}
+}
+
+// parsedListMapItemKVs is a parsed +k8s:listMapItem argument: the key=value
+// pairs that identify the matched item, and (when the JSONPath-style syntax
+// is used) an optional direct sub-field of the matched item that the
+// payload tag actually applies to.
 type parsedListMapItemKVs struct {
-	MatcherPairs [][2]string
+	Matchers []listMapItemMatcher
+	SubField string
 }
 
 var (
-	validateListMapItemByKeyValues = types.Name{Package: libValidationPkg, Name: "ListMapItemByKeyValues"}
+	validateListMapItemByKeyValues  = types.Name{Package: libValidationPkg, Name: "ListMapItemByKeyValues"}
+	validateListMapItemsByKeyValues = types.Name{Package: libValidationPkg, Name: "ListMapItemsByKeyValues"}
 )
 
 func (stv *listMapItemTagValidator) GetValidations(context Context, args []string, payload string) (Validations, error) {
+	multiMatch := false
+	if len(args) == 2 && args[1] == "multi" {
+		multiMatch = true
+		args = args[:1]
+	}
 	if len(args) != 1 {
-		return Validations{}, fmt.Errorf("requires exactly one arg")
+		return Validations{}, fmt.Errorf(`requires exactly one arg, optionally followed by a second "multi" arg`)
 	}
 	parsedArg, err := parseListMapItemArg(args[0])
 	if err != nil {
@@ -74,14 +117,14 @@ func (stv *listMapItemTagValidator) GetValidations(context Context, args []strin
 
 	// This tag can apply to value and pointer fields, as well as typedefs
 	// (which should never be pointers). We need to check the concrete type.
-	t := NonPointer(NativeType(context.Type))
+	t := util.NonPointer(util.NativeType(context.Type))
 	fakeComments := []string{payload}
 
 	if !(t.Kind == types.Slice) {
 		return Validations{}, fmt.Errorf("can only be used on list types")
 	}
 
-	elemT := NonPointer(NativeType(t.Elem))
+	elemT := util.NonPointer(util.NativeType(t.Elem))
 	if elemT.Kind != types.Struct {
 		return Validations{}, fmt.Errorf("can only be used on list of structs")
 	}
@@ -97,8 +140,8 @@ func (stv *listMapItemTagValidator) GetValidations(context Context, args []strin
 
 	foundRequiredKeys := 0
 	for _, fieldName := range listMap.keyFields {
-		for _, pair := range parsedArg.MatcherPairs {
-			if member := getMemberByJSON(elemT, pair[0]); member != nil && member.Name == fieldName {
+		for _, m := range parsedArg.Matchers {
+			if member := util.GetMemberByJSON(elemT, m.Key); member != nil && member.Name == fieldName {
 				foundRequiredKeys++
 				break
 			}
@@ -109,94 +152,252 @@ func (stv *listMapItemTagValidator) GetValidations(context Context, args []strin
 		return Validations{}, fmt.Errorf("listMapItem field-value pairs must include all +k8s:listMapKey fields. ")
 	}
 
-	for _, pair := range parsedArg.MatcherPairs {
-		if getMemberByJSON(elemT, pair[0]) == nil {
-			return Validations{}, fmt.Errorf("list item has has no field with JSON name %q", pair[0])
+	for _, m := range parsedArg.Matchers {
+		if util.GetMemberByJSON(elemT, m.Key) == nil {
+			return Validations{}, fmt.Errorf("list item has has no field with JSON name %q", m.Key)
 		}
 	}
 
-	// Generates context path like Struct.Conditions[status="true",type="Approved"].
-	subContextPath := generatePathForMap(parsedArg.MatcherPairs)
-	fakeMember := createFakeMember(elemT, parsedArg.MatcherPairs)
+	// Generates context path like Struct.Conditions[status="true",type="Approved"]
+	// (or, with listMapKeySMDPathFormat, Struct.Conditions[k:{"status":"true","type":"Approved"}]).
+	subContextPath := context.Path.Key(generatePathForMap(parsedArg.Matchers, listMapKeySMDPathFormat))
+	fakeMember := createFakeMember(elemT, parsedArg.Matchers, listMapKeySMDPathFormat)
+
+	// If the selector has a trailing ".<subField>" (JSONPath-style syntax
+	// only), the payload tag applies to that direct field of the matched
+	// item rather than to the item itself; narrow subField/Type/Path/Parent
+	// accordingly, same as +k8s:subfield's direct-field-access case.
+	subContextType := elemT
+	subContextMember := fakeMember
+	subContextParent := context.Parent
+	var subFieldMember *types.Member
+	if parsedArg.SubField != "" {
+		subFieldMember = util.GetMemberByJSON(elemT, parsedArg.SubField)
+		if subFieldMember == nil {
+			return Validations{}, fmt.Errorf("matched list item has no field with JSON name %q", parsedArg.SubField)
+		}
+		subContextType = subFieldMember.Type
+		subContextMember = subFieldMember
+		subContextParent = elemT
+		subContextPath = subContextPath.Child(parsedArg.SubField)
+	}
 
 	subContext := Context{
-		Member: fakeMember,
+		Member: subContextMember,
 		Scope:  ScopeField,
-		Type:   elemT,
-		// TODO(aaron-prindle) for +k8s:unionMember support need to plumb this.
-		Parent: nil,
-		Path:   context.Path.Key(subContextPath),
+		Type:   subContextType,
+		Parent: subContextParent,
+		Path:   subContextPath,
 	}
 
-	if validations, err := stv.validator.ExtractValidations(subContext, fakeComments); err != nil {
+	validations, err := stv.validator.ExtractValidations(subContext, fakeComments)
+	if err != nil {
 		return Validations{}, err
-	} else {
-
-		result := Validations{}
-		result.Variables = append(result.Variables, validations.Variables...)
+	}
 
-		matchFn, err := createMatchFn(elemT, parsedArg.MatcherPairs)
-		if err != nil {
-			return Validations{}, err
+	result := Validations{}
+	result.Variables = append(result.Variables, validations.Variables...)
+
+	itemFns := validations.Functions
+	if subFieldMember != nil {
+		accessorParentType := types.PointerTo(elemT)
+		returnedSubFieldType := subFieldMember.Type
+		fieldExprPrefix := ""
+		if !isNilableType(subFieldMember.Type) {
+			returnedSubFieldType = types.PointerTo(subFieldMember.Type)
+			fieldExprPrefix = "&"
 		}
-
+		getFn := FunctionLiteral{
+			Parameters: []ParamResult{{"o", accessorParentType}},
+			Results:    []ParamResult{{"", returnedSubFieldType}},
+			Body:       fmt.Sprintf("return %so.%s", fieldExprPrefix, subFieldMember.Name),
+		}
+		itemFns = nil
 		for _, vfn := range validations.Functions {
-			f := Function(
-				listMapItemTagName,
-				vfn.Flags,
-				validateListMapItemByKeyValues,
-				matchFn,
-				WrapperFunction{vfn, elemT},
-			)
-			result.Functions = append(result.Functions, f)
+			itemFns = append(itemFns, Function(listMapItemTagName, vfn.Flags, validateSubfield, parsedArg.SubField, getFn, WrapperFunction{vfn, subFieldMember.Type}))
 		}
-		return result, nil
+	}
 
+	matchFn, err := createMatchFn(elemT, parsedArg.Matchers)
+	if err != nil {
+		return Validations{}, err
 	}
+
+	validateFn := validateListMapItemByKeyValues
+	if multiMatch {
+		validateFn = validateListMapItemsByKeyValues
+	}
+	for _, vfn := range itemFns {
+		f := Function(
+			listMapItemTagName,
+			vfn.Flags,
+			validateFn,
+			matchFn,
+			WrapperFunction{vfn, elemT},
+		)
+		result.Functions = append(result.Functions, f)
+	}
+	return result, nil
 }
 
+// listMapItemJSONPathPattern matches the JSONPath-style +k8s:listMapItem
+// selector syntax, e.g. `items[?(@.key=="temp")]` or
+// `multiKey[?(@.key1=="a" && @.key2=="1")].stringField`. The leading field
+// name is optional and, when present, is only sanity-checked against the
+// field the tag is declared on -- it does not change which field is
+// matched, since that is always determined by the tag's own context. The
+// trailing ".<subField>" is optional and names a direct field of the
+// matched item that the payload tag actually applies to.
+var listMapItemJSONPathPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)?\[\?\((.+)\)\](?:\.([A-Za-z_][A-Za-z0-9_]*))?$`)
+
+// listMapItemComparisonPattern matches a single `@.<field>=="<value>"`
+// predicate clause.
+var listMapItemComparisonPattern = regexp.MustCompile(`^@\.([A-Za-z_][A-Za-z0-9_]*)==("(?:[^"\\]|\\.)*")$`)
+
 func parseListMapItemArg(argStr string) (*parsedListMapItemKVs, error) {
-	var matcherPairs [][2]string
 	// Remove backticks from raw string arg.
-	argStr = strings.Trim(argStr, "`")
-	if err := json.Unmarshal([]byte(argStr), &matcherPairs); err == nil {
-		if len(matcherPairs) == 0 {
+	trimmed := strings.Trim(argStr, "`")
+
+	var rawPairs [][2]interface{}
+	if err := json.Unmarshal([]byte(trimmed), &rawPairs); err == nil {
+		if len(rawPairs) == 0 {
 			return nil, fmt.Errorf("listMapItem matcher pairs cannot be empty")
 		}
 
-		for i, pair := range matcherPairs {
-			if len(pair) != 2 {
-				return nil, fmt.Errorf("listMapItem pair at index %d must have exactly 2 elements", i)
+		matchers := make([]listMapItemMatcher, len(rawPairs))
+		for i, pair := range rawPairs {
+			key, ok := pair[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("listMapItem pair at index %d: key must be a string, got %T", i, pair[0])
 			}
+			matchers[i] = listMapItemMatcher{Key: key, Value: pair[1]}
 		}
 		// Sort by key for consistent output
-		sort.Slice(matcherPairs, func(i, j int) bool {
-			return matcherPairs[i][0] < matcherPairs[j][0]
+		sort.Slice(matchers, func(i, j int) bool {
+			return matchers[i].Key < matchers[j].Key
 		})
 		return &parsedListMapItemKVs{
-			MatcherPairs: matcherPairs,
+			Matchers: matchers,
+		}, nil
+	}
+
+	var rawObj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(trimmed), &rawObj); err == nil {
+		if len(rawObj) == 0 {
+			return nil, fmt.Errorf("listMapItem matcher object cannot be empty")
+		}
+
+		keys := make([]string, 0, len(rawObj))
+		for key := range rawObj {
+			keys = append(keys, key)
+		}
+		// Sort by key for consistent output, matching the array-of-pairs form.
+		sort.Strings(keys)
+
+		matchers := make([]listMapItemMatcher, 0, len(keys))
+		for _, key := range keys {
+			var value interface{}
+			if err := json.Unmarshal(rawObj[key], &value); err != nil {
+				return nil, fmt.Errorf("listMapItem matcher object key %q has an invalid value: %w", key, err)
+			}
+			matchers = append(matchers, listMapItemMatcher{Key: key, Value: value})
+		}
+		return &parsedListMapItemKVs{
+			Matchers: matchers,
 		}, nil
 	}
-	return nil, fmt.Errorf("listMapItem arguments incorrect, JSON parsing failed")
+
+	m := listMapItemJSONPathPattern.FindStringSubmatch(trimmed)
+	if m == nil {
+		return nil, fmt.Errorf("listMapItem arguments incorrect: must be a JSON array of [key,value] pairs, a JSON object of key:value matchers, or a JSONPath-style selector like `items[?(@.key==\"value\")]`, got %q", argStr)
+	}
+
+	// The JSONPath-style selector only supports quoted string values; use
+	// the JSON-array-of-pairs form above for non-string key kinds.
+	predicate := m[2]
+	var matchers []listMapItemMatcher
+	for _, clause := range strings.Split(predicate, "&&") {
+		clause = strings.TrimSpace(clause)
+		cm := listMapItemComparisonPattern.FindStringSubmatch(clause)
+		if cm == nil {
+			return nil, fmt.Errorf("listMapItem selector clause %q must have the form @.<field>==\"<value>\"", clause)
+		}
+		value, err := strconv.Unquote(cm[2])
+		if err != nil {
+			return nil, fmt.Errorf("listMapItem selector clause %q has an invalid quoted value: %w", clause, err)
+		}
+		matchers = append(matchers, listMapItemMatcher{Key: cm[1], Value: value})
+	}
+	sort.Slice(matchers, func(i, j int) bool {
+		return matchers[i].Key < matchers[j].Key
+	})
+
+	return &parsedListMapItemKVs{
+		Matchers: matchers,
+		SubField: m[3],
+	}, nil
+}
+
+// literalForMatcherValue renders a listMapItemMatcher's value as a Go literal
+// appropriate for member's (native) kind, casting to member's own named type
+// when it is a typedef -- the same convention +k8s:transition's
+// formatTransitionEndpoint uses for enum-like string aliases (e.g.
+// `v1.Protocol("TCP")`).
+func literalForMatcherValue(member *types.Member, value interface{}) (string, error) {
+	nativeT := util.NativeType(member.Type)
+
+	var literal string
+	switch {
+	case nativeT == types.String:
+		s, ok := value.(string)
+		if !ok {
+			return "", fmt.Errorf("key field %q is a string type but selector value %v is a %T", member.Name, value, value)
+		}
+		literal = fmt.Sprintf("%q", s)
+	case nativeT == types.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return "", fmt.Errorf("key field %q is a bool type but selector value %v is a %T", member.Name, value, value)
+		}
+		literal = fmt.Sprintf("%t", b)
+	case types.IsInteger(nativeT) || nativeT == types.Float32 || nativeT == types.Float64:
+		f, ok := value.(float64)
+		if !ok {
+			return "", fmt.Errorf("key field %q is a numeric type but selector value %v is a %T", member.Name, value, value)
+		}
+		if types.IsInteger(nativeT) {
+			i := int64(f)
+			if float64(i) != f {
+				return "", fmt.Errorf("key field %q is an integer type but selector value %v is not a whole number", member.Name, f)
+			}
+			literal = fmt.Sprintf("%d", i)
+		} else {
+			literal = fmt.Sprintf("%v", f)
+		}
+	default:
+		return "", fmt.Errorf("key field %q for listMapItem must be a comparable scalar (string, bool, or numeric type), got %s", member.Name, member.Type.String())
+	}
+
+	if member.Type != nativeT {
+		literal = fmt.Sprintf("%s(%s)", member.Type.Name.Name, literal)
+	}
+	return literal, nil
 }
 
-func createMatchFn(elemT *types.Type, matcherPairs [][2]string) (FunctionLiteral, error) {
+func createMatchFn(elemT *types.Type, matchers []listMapItemMatcher) (FunctionLiteral, error) {
 	var matchFuncBody strings.Builder
 	matchFuncBody.WriteString("if item == nil { return false }\n")
 
 	var conditions []string
 
-	for _, pair := range matcherPairs {
-		jsonKey := pair[0]
-		value := pair[1]
-		member := getMemberByJSON(elemT, jsonKey)
+	for _, m := range matchers {
+		member := util.GetMemberByJSON(elemT, m.Key)
 
-		var condition string
-		if NativeType(member.Type).Kind != types.Builtin {
-			return FunctionLiteral{}, fmt.Errorf("key field %q for listMapItem must be of type string or an alias to string, got %s", member.Name, member.Type.String())
+		literal, err := literalForMatcherValue(member, m.Value)
+		if err != nil {
+			return FunctionLiteral{}, err
 		}
-		condition = fmt.Sprintf("item.%s == %q", member.Name, value)
-		conditions = append(conditions, condition)
+		conditions = append(conditions, fmt.Sprintf("item.%s == %s", member.Name, literal))
 	}
 
 	matchFuncBody.WriteString(fmt.Sprintf("return %s", strings.Join(conditions, " && ")))
@@ -207,23 +408,62 @@ func createMatchFn(elemT *types.Type, matcherPairs [][2]string) (FunctionLiteral
 	}, nil
 }
 
-func generatePathForMap(matcherPairs [][2]string) string {
+// generatePathForMap renders matchers as a path-segment string identifying
+// the matched list-map item. By default this is the legacy bespoke
+// "key=value,..." form; when useSMDFormat is set it instead calls
+// generatePathElementForMap to produce a structured-merge-diff
+// PathElement.Key-compatible form.
+func generatePathForMap(matchers []listMapItemMatcher, useSMDFormat bool) string {
+	if useSMDFormat {
+		return generatePathElementForMap(matchers)
+	}
 	var sb strings.Builder
-	for i, pair := range matcherPairs {
+	for i, m := range matchers {
 		if i > 0 {
 			sb.WriteString(",")
 		}
-		sb.WriteString(fmt.Sprintf("%s=%q", pair[0], pair[1]))
+		if s, ok := m.Value.(string); ok {
+			sb.WriteString(fmt.Sprintf("%s=%q", m.Key, s))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s=%v", m.Key, m.Value))
+		}
 	}
 	return sb.String()
 }
 
-func createFakeMember(itemType *types.Type, matcherPairs [][2]string) *types.Member {
-	var keyParts []string
-	for _, pair := range matcherPairs {
-		keyParts = append(keyParts, fmt.Sprintf("%s=%s", pair[0], pair[1]))
+// generatePathElementForMap renders matchers as a structured-merge-diff
+// fieldpath.PathElement.Key-compatible string: "k:" followed by the matcher
+// pairs encoded as a JSON object, e.g. k:{"status":"true","type":"Approved"}.
+// Go's json.Marshal of a map already sorts keys alphabetically, which is the
+// same canonical field-name ordering structured-merge-diff uses for list-map
+// keys, so paths generated this way can be correlated with the PathElements
+// server-side-apply reports in an object's managed fields.
+func generatePathElementForMap(matchers []listMapItemMatcher) string {
+	kv := make(map[string]interface{}, len(matchers))
+	for _, m := range matchers {
+		kv[m.Key] = m.Value
+	}
+	b, err := json.Marshal(kv)
+	if err != nil {
+		// matcher values are always JSON scalars decoded from the tag's own
+		// argument, so this cannot fail in practice.
+		return fmt.Sprintf("k:<unencodable listMapItem matchers: %v>", err)
+	}
+	return "k:" + string(b)
+}
+
+func createFakeMember(itemType *types.Type, matchers []listMapItemMatcher, useSMDFormat bool) *types.Member {
+	var memberKey string
+	if useSMDFormat {
+		memberKey = generatePathElementForMap(matchers)
+	} else {
+		var keyParts []string
+		for _, m := range matchers {
+			keyParts = append(keyParts, fmt.Sprintf("%s=%v", m.Key, m.Value))
+		}
+		memberKey = strings.Join(keyParts, ",")
 	}
-	memberName := fmt.Sprintf("_listItem[%s]", strings.Join(keyParts, ","))
+	memberName := fmt.Sprintf("_listItem[%s]", memberKey)
 
 	fakeMember := &types.Member{
 		Name:         memberName,
@@ -241,10 +481,30 @@ func (stv listMapItemTagValidator) Docs() TagDoc {
 		Tag:    stv.TagName(),
 		Scopes: stv.ValidScopes().UnsortedList(),
 		Description: "Declares a validation for an item of a slice declared as a +k8s:listType=map." +
-			"The item to match is declared by providing field-value pair arguments. All +k8s:listMapKey fields must be included in the field-value pair arguments.",
+			"The item to match is declared by providing field-value pair arguments. All +k8s:listMapKey fields must be included in the field-value pair arguments." +
+			" A JSONPath-style selector is also accepted, optionally narrowed to a single direct subfield of the matched item; this does not" +
+			" support selecting into a nested +k8s:listType=map, which must still be done by chaining +k8s:listMapItem tags." +
+			" By default only the first matching item of each list is validated, on the assumption that the match" +
+			" uniquely identifies one item; a trailing \"multi\" argument instead validates every matching item," +
+			" pairing new and old matches up in list order (an unpaired match is an add or a delete) and reporting" +
+			" a field.Duplicate error for every match past the first in either list." +
+			" In the JSON-array-of-pairs and JSON-object forms, each <value> may be a JSON string, number, or boolean" +
+			" and must match the kind of the key field it is compared against (an enum-like string typedef field is" +
+			" compared by converting the string value to that named type). The JSONPath-style selector only supports" +
+			" string values." +
+			" The generator's own context path renders the matched item's key as \"key=value,...\" by default, or, when" +
+			" SetListMapKeySMDPathFormat(true) has been called, as a structured-merge-diff" +
+			" PathElement.Key-compatible \"k:{...}\" JSON object. Runtime validation errors report the matched item" +
+			" by its list index in either case.",
 		Args: []TagArgDoc{
 			{
-				Description: `[["<list-map-key-field-json-name>","<value>"], ["<list-map-key-field-json-name>", "<value>"], ...]`,
+				Description: `[["<list-map-key-field-json-name>",<value>], ["<list-map-key-field-json-name>", <value>], ...](, "multi")?`,
+			},
+			{
+				Description: `{"<list-map-key-field-json-name>": <value>, "<list-map-key-field-json-name>": <value>, ...}`,
+			},
+			{
+				Description: `<list-map-key-field-json-name>[?(@.<field>=="<value>" && ...)](.<subfield-json-name>)?(, "multi")?`,
 			},
 		},
 		Payloads: []TagPayloadDoc{{
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	listMapItemsTagName = "k8s:listMapItems"
+)
+
+// listMapItemsTagValidator is +k8s:listMapItem's plural sibling: it shares
+// the same argument parser (parseListMapItemArg) and match-function
+// generator (createMatchFn), but unlike +k8s:listMapItem it does not
+// require the matcher to cover every declared +k8s:listMapKey field, so it
+// may match any number of items of the list (zero, one, or many) instead of
+// exactly one.
+type listMapItemsTagValidator struct {
+	validator   Validator
+	byFieldPath map[string]*listMetadata
+}
+
+func (stv *listMapItemsTagValidator) Init(cfg Config) {
+	stv.validator = cfg.Validator
+	if stv.byFieldPath == nil {
+		stv.byFieldPath = make(map[string]*listMetadata)
+	}
+}
+
+func (listMapItemsTagValidator) TagName() string {
+	return listMapItemsTagName
+}
+
+var listMapItemsTagValidScopes = sets.New(ScopeField)
+
+func (listMapItemsTagValidator) ValidScopes() sets.Set[Scope] {
+	return listMapItemsTagValidScopes
+}
+
+// LateTagValidator ensures this runs after listMapKey tags are processed
+func (listMapItemsTagValidator) LateTagValidator() {}
+
+var validateListMapItemsByPartialKeyValues = types.Name{Package: libValidationPkg, Name: "ListMapItemsByPartialKeyValues"}
+
+func (stv *listMapItemsTagValidator) GetValidations(context Context, args []string, payload string) (Validations, error) {
+	if len(args) != 1 {
+		return Validations{}, fmt.Errorf("requires exactly one arg")
+	}
+	parsedArg, err := parseListMapItemArg(args[0])
+	if err != nil {
+		return Validations{}, err
+	}
+	if parsedArg.SubField != "" {
+		return Validations{}, fmt.Errorf("%s does not support a JSONPath-style subfield selector; narrow the payload tag itself instead", listMapItemsTagName)
+	}
+
+	// This tag can apply to value and pointer fields, as well as typedefs
+	// (which should never be pointers). We need to check the concrete type.
+	t := util.NonPointer(util.NativeType(context.Type))
+	fakeComments := []string{payload}
+
+	if !(t.Kind == types.Slice) {
+		return Validations{}, fmt.Errorf("can only be used on list types")
+	}
+
+	elemT := util.NonPointer(util.NativeType(t.Elem))
+	if elemT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("can only be used on list of structs")
+	}
+
+	if context.Member == nil {
+		return Validations{}, fmt.Errorf("unexpected nil context member")
+	}
+
+	listMap, found := stv.byFieldPath[context.Path.String()]
+	if !found || !listMap.declaredAsMap || len(listMap.keyFields) == 0 {
+		return Validations{}, fmt.Errorf("must have +k8s:listType=map and '+k8s:listMapKey=...' annotations")
+	}
+
+	for _, m := range parsedArg.Matchers {
+		if util.GetMemberByJSON(elemT, m.Key) == nil {
+			return Validations{}, fmt.Errorf("list item has no field with JSON name %q", m.Key)
+		}
+	}
+
+	// Generates context path like Struct.Conditions[type="Approved"], same as
+	// +k8s:listMapItem, but here the matcher need not cover every
+	// +k8s:listMapKey field.
+	subContextPath := context.Path.Key(generatePathForMap(parsedArg.Matchers, listMapKeySMDPathFormat))
+	fakeMember := createFakeMember(elemT, parsedArg.Matchers, listMapKeySMDPathFormat)
+
+	subContext := Context{
+		Member: fakeMember,
+		Scope:  ScopeField,
+		Type:   elemT,
+		Parent: context.Parent,
+		Path:   subContextPath,
+	}
+
+	validations, err := stv.validator.ExtractValidations(subContext, fakeComments)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	result := Validations{}
+	result.Variables = append(result.Variables, validations.Variables...)
+
+	matchFn, err := createMatchFn(elemT, parsedArg.Matchers)
+	if err != nil {
+		return Validations{}, err
+	}
+	keyPathFn, err := createListMapPathFn(elemT, listMap.keyFields)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	for _, vfn := range validations.Functions {
+		f := Function(
+			listMapItemsTagName,
+			vfn.Flags,
+			validateListMapItemsByPartialKeyValues,
+			matchFn,
+			keyPathFn,
+			WrapperFunction{vfn, elemT},
+		)
+		result.Functions = append(result.Functions, f)
+	}
+	return result, nil
+}
+
+// createListMapPathFn builds a FunctionLiteral that renders an item's
+// +k8s:listMapKey fields (identified by their Go field names, the same
+// convention createListMapKeyFn uses for UniqueItemsByKey) as a
+// human-readable "Field1=value1,Field2=value2" path segment. This is how
+// ListMapItemsByPartialKeyValues both pairs new/old matches and reports
+// which matched item a validation failure belongs to, since the matcher
+// arguments alone may not identify a unique item.
+func createListMapPathFn(elemT *types.Type, keyFields []string) (FunctionLiteral, error) {
+	var parts []string
+	for _, fieldName := range keyFields {
+		parts = append(parts, fmt.Sprintf("fmt.Sprintf(%q, item.%s)", fieldName+"=%v", fieldName))
+	}
+	body := fmt.Sprintf(`return strings.Join([]string{%s}, ",")`, strings.Join(parts, ", "))
+	return FunctionLiteral{
+		Parameters: []ParamResult{{"item", types.PointerTo(elemT)}},
+		Results:    []ParamResult{{"", types.String}},
+		Body:       body,
+	}, nil
+}
+
+func (stv listMapItemsTagValidator) Docs() TagDoc {
+	doc := TagDoc{
+		Tag:    stv.TagName(),
+		Scopes: stv.ValidScopes().UnsortedList(),
+		Description: "Declares a validation for every item of a slice declared as a +k8s:listType=map that matches" +
+			" the given field-value pair arguments. Unlike +k8s:listMapItem, the matcher does not need to cover" +
+			" every +k8s:listMapKey field, so it may match any number of items (including zero); each matched item" +
+			" is validated independently, and the reported path identifies the matched item by its full" +
+			" +k8s:listMapKey values rather than by list index, so ratcheting still compares the right new/old" +
+			" pair even if matching items change position in the list. That path segment is always the" +
+			" \"Field=value,...\" text of the item's key fields by Go field name; as with +k8s:listMapItem," +
+			" SetListMapKeySMDPathFormat(true) switches only the generator's own context path to the \"k:{...}\" form.",
+		Args: []TagArgDoc{
+			{
+				Description: `[["<field-json-name>",<value>], ["<field-json-name>", <value>], ...]`,
+			},
+			{
+				Description: `{"<field-json-name>": <value>, "<field-json-name>": <value>, ...}`,
+			},
+		},
+		Payloads: []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "The tag to evaluate for each matching list item.",
+		}},
+	}
+	return doc
+}
+
+func init() {
+	RegisterTagValidator(&listMapItemsTagValidator{})
+}
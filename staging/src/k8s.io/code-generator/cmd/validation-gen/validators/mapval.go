@@ -0,0 +1,326 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	mapTypeTagName      = "k8s:mapType"
+	eachMapKeyTagName   = "k8s:eachMapKey"
+	eachMapValueTagName = "k8s:eachMapValue"
+	eachKeyTagName      = "k8s:eachKey"
+	eachValTagName      = "k8s:eachVal"
+	mapItemTagName      = "k8s:mapItem"
+)
+
+func init() {
+	RegisterTagValidator(mapTypeTagValidator{})
+	RegisterTagValidator(&eachMapKeyTagValidator{tagName: eachMapKeyTagName})
+	RegisterTagValidator(&eachMapKeyTagValidator{tagName: eachKeyTagName})
+	RegisterTagValidator(&eachMapValueTagValidator{tagName: eachMapValueTagName})
+	RegisterTagValidator(&eachMapValueTagValidator{tagName: eachValTagName})
+	RegisterTagValidator(&mapItemTagValidator{})
+}
+
+// mapTypeTagValidator declares the merge strategy for a Go-native map field.
+// Unlike +k8s:listType, it does not gate the other map tags in this file:
+// +k8s:eachMapKey, +k8s:eachMapValue, and +k8s:mapItem all apply to any map
+// field, declared or not, since Go maps are inherently keyed.
+type mapTypeTagValidator struct{}
+
+func (mapTypeTagValidator) Init(_ Config) {}
+
+func (mapTypeTagValidator) TagName() string {
+	return mapTypeTagName
+}
+
+var mapTypeTagValidScopes = sets.New(ScopeField)
+
+func (mapTypeTagValidator) ValidScopes() sets.Set[Scope] {
+	return mapTypeTagValidScopes
+}
+
+func (mapTypeTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	t := util.NonPointer(util.NativeType(context.Type))
+	if t.Kind != types.Map {
+		return Validations{}, fmt.Errorf("can only be used on map types (%s)", rootTypeString(context.Type, t))
+	}
+	switch tag.Value {
+	case "atomic", "granular":
+	default:
+		return Validations{}, fmt.Errorf("must be 'atomic' or 'granular', got %q", tag.Value)
+	}
+	// No runtime function: this tag only informs serialization/merge-patch
+	// metadata, which is out of scope for the validation-gen output.
+	return Validations{}, nil
+}
+
+func (mtv mapTypeTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    mtv.TagName(),
+		Scopes: mtv.ValidScopes().UnsortedList(),
+		Description: "Declares the merge strategy for a map field: 'granular' entries may be merged key-by-key by " +
+			"a three-way merge patch, while 'atomic' maps are always replaced as a whole.",
+		Payloads: []TagPayloadDoc{{
+			Description: "atomic|granular",
+		}},
+		PayloadsRequired: true,
+		PayloadsType:     codetags.ValueTypeString,
+	}
+}
+
+var (
+	eachMapKeyValidator   = types.Name{Package: libValidationPkg, Name: "EachMapKey"}
+	eachMapValueValidator = types.Name{Package: libValidationPkg, Name: "EachMapVal"}
+	validateMapItem       = types.Name{Package: libValidationPkg, Name: "MapItem"}
+)
+
+// eachMapKeyTagValidator backs both +k8s:eachMapKey and its shorthand alias
+// +k8s:eachKey; the two tag names share this one implementation (the same
+// pattern limits.go's minMaxTagValidator uses for minimum/maximum/etc.), so
+// tagName records which name a given registration should answer to.
+type eachMapKeyTagValidator struct {
+	tagName   string
+	validator Validator
+}
+
+func (ekv *eachMapKeyTagValidator) Init(cfg Config) {
+	ekv.validator = cfg.Validator
+}
+
+func (ekv eachMapKeyTagValidator) TagName() string {
+	return ekv.tagName
+}
+
+var eachMapKeyTagValidScopes = sets.New(ScopeField)
+
+func (eachMapKeyTagValidator) ValidScopes() sets.Set[Scope] {
+	return eachMapKeyTagValidScopes
+}
+
+func (ekv *eachMapKeyTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	t := util.NonPointer(util.NativeType(context.Type))
+	if t.Kind != types.Map {
+		return Validations{}, fmt.Errorf("can only be used on map types (%s)", rootTypeString(context.Type, t))
+	}
+	if tag.ValueType != codetags.ValueTypeTag || tag.ValueTag == nil {
+		return Validations{}, fmt.Errorf("%s requires a validation tag as its value payload", ekv.tagName)
+	}
+
+	keyT := t.Key
+	subContext := Context{
+		Scope:  ScopeMapKey,
+		Type:   keyT,
+		Parent: t,
+		Path:   context.Path.Key("*"),
+		Member: context.Member,
+	}
+	validations, err := ekv.validator.ExtractValidations(subContext, *tag.ValueTag)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	result := Validations{}
+	result.Variables = append(result.Variables, validations.Variables...)
+	for _, vfn := range validations.Functions {
+		f := Function(ekv.tagName, vfn.Flags, eachMapKeyValidator, WrapperFunction{vfn, keyT})
+		result.Functions = append(result.Functions, f)
+	}
+	return result, nil
+}
+
+func (ekv eachMapKeyTagValidator) Docs() TagDoc {
+	doc := TagDoc{
+		Tag:    ekv.TagName(),
+		Scopes: ekv.ValidScopes().UnsortedList(),
+		Description: "Declares a validation for every key of a map field. Keys are visited in ascending order, so " +
+			"reported error paths (e.g. foo[key]) are stable across calls.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "The tag to evaluate for each key of the map.",
+		}},
+		PayloadsRequired: true,
+		PayloadsType:     codetags.ValueTypeTag,
+	}
+	if ekv.tagName == eachKeyTagName {
+		doc.Description = "Shorthand alias for +k8s:eachMapKey."
+	}
+	return doc
+}
+
+// eachMapValueTagValidator backs both +k8s:eachMapValue and its shorthand
+// alias +k8s:eachVal; see eachMapKeyTagValidator's comment for why the two
+// names share one implementation.
+type eachMapValueTagValidator struct {
+	tagName   string
+	validator Validator
+}
+
+func (evv *eachMapValueTagValidator) Init(cfg Config) {
+	evv.validator = cfg.Validator
+}
+
+func (evv eachMapValueTagValidator) TagName() string {
+	return evv.tagName
+}
+
+var eachMapValueTagValidScopes = sets.New(ScopeField)
+
+func (eachMapValueTagValidator) ValidScopes() sets.Set[Scope] {
+	return eachMapValueTagValidScopes
+}
+
+func (evv *eachMapValueTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	t := util.NonPointer(util.NativeType(context.Type))
+	if t.Kind != types.Map {
+		return Validations{}, fmt.Errorf("can only be used on map types (%s)", rootTypeString(context.Type, t))
+	}
+	if tag.ValueType != codetags.ValueTypeTag || tag.ValueTag == nil {
+		return Validations{}, fmt.Errorf("%s requires a validation tag as its value payload", evv.tagName)
+	}
+
+	valT := t.Elem
+	subContext := Context{
+		Scope:  ScopeMapVal,
+		Type:   valT,
+		Parent: t,
+		Path:   context.Path.Key("*"),
+		Member: context.Member,
+	}
+	validations, err := evv.validator.ExtractValidations(subContext, *tag.ValueTag)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	result := Validations{}
+	result.Variables = append(result.Variables, validations.Variables...)
+	for _, vfn := range validations.Functions {
+		f := Function(evv.tagName, vfn.Flags, eachMapValueValidator, WrapperFunction{vfn, valT})
+		result.Functions = append(result.Functions, f)
+	}
+	return result, nil
+}
+
+func (evv eachMapValueTagValidator) Docs() TagDoc {
+	doc := TagDoc{
+		Tag:    evv.TagName(),
+		Scopes: evv.ValidScopes().UnsortedList(),
+		Description: "Declares a validation for every value of a map field, with ratcheting: on update, an entry " +
+			"whose key is present in both the old and new map, and whose value is unchanged, is not re-validated.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "The tag to evaluate for each value of the map.",
+		}},
+		PayloadsRequired: true,
+		PayloadsType:     codetags.ValueTypeTag,
+	}
+	if evv.tagName == eachValTagName {
+		doc.Description = "Shorthand alias for +k8s:eachMapValue."
+	}
+	return doc
+}
+
+// mapItemTagValidator declares a validation for the value stored under one
+// statically-known key of a map field, analogous to +k8s:item for
+// +k8s:listType=map slices. It requires string-keyed maps, since the key
+// literal is supplied as a tag argument.
+type mapItemTagValidator struct {
+	validator Validator
+}
+
+func (miv *mapItemTagValidator) Init(cfg Config) {
+	miv.validator = cfg.Validator
+}
+
+func (mapItemTagValidator) TagName() string {
+	return mapItemTagName
+}
+
+var mapItemTagValidScopes = sets.New(ScopeField)
+
+func (mapItemTagValidator) ValidScopes() sets.Set[Scope] {
+	return mapItemTagValidScopes
+}
+
+func (miv *mapItemTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	t := util.NonPointer(util.NativeType(context.Type))
+	if t.Kind != types.Map {
+		return Validations{}, fmt.Errorf("can only be used on map types (%s)", rootTypeString(context.Type, t))
+	}
+	keyT := util.NativeType(t.Key)
+	if keyT.Kind != types.Builtin || keyT != types.String {
+		return Validations{}, fmt.Errorf("%s can only be used on maps with string keys, got %s", mapItemTagName, t.Key.String())
+	}
+
+	posArg, found := tag.PositionalArg()
+	if !found || posArg.Value == "" {
+		return Validations{}, fmt.Errorf("%s requires the map key as its positional argument", mapItemTagName)
+	}
+	if tag.ValueType != codetags.ValueTypeTag || tag.ValueTag == nil {
+		return Validations{}, fmt.Errorf("%s requires a validation tag as its value payload", mapItemTagName)
+	}
+
+	valT := t.Elem
+	subContext := Context{
+		Scope:  ScopeField,
+		Type:   valT,
+		Parent: t,
+		Path:   context.Path.Key(posArg.Value),
+		Member: context.Member,
+	}
+	validations, err := miv.validator.ExtractValidations(subContext, *tag.ValueTag)
+	if err != nil {
+		return Validations{}, err
+	}
+
+	result := Validations{}
+	result.Variables = append(result.Variables, validations.Variables...)
+	keyLiteral := Literal(fmt.Sprintf("%q", posArg.Value))
+	for _, vfn := range validations.Functions {
+		f := Function(mapItemTagName, vfn.Flags, validateMapItem, keyLiteral, WrapperFunction{vfn, valT})
+		result.Functions = append(result.Functions, f)
+	}
+	return result, nil
+}
+
+func (miv mapItemTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    miv.TagName(),
+		Scopes: miv.ValidScopes().UnsortedList(),
+		Description: "Declares a validation for the value stored under a single, statically-known key of a " +
+			"string-keyed map field.",
+		Args: []TagArgDoc{{
+			Description: "<key>",
+			Required:    true,
+			Type:        codetags.ArgTypeString,
+		}},
+		Payloads: []TagPayloadDoc{{
+			Description: "<validation-tag>",
+			Docs:        "The tag to evaluate for the value under the given key, if present in either the old or new map.",
+		}},
+		PayloadsRequired: true,
+		PayloadsType:     codetags.ValueTypeTag,
+		Usage:            `+k8s:mapItem("target")=+k8s:immutable`,
+	}
+}
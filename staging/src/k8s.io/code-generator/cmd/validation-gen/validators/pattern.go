@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	patternTagName = "k8s:pattern"
+)
+
+func init() {
+	RegisterTagValidator(patternTagValidator{})
+}
+
+type patternTagValidator struct{}
+
+func (patternTagValidator) Init(_ Config) {}
+
+func (patternTagValidator) TagName() string {
+	return patternTagName
+}
+
+var patternTagValidScopes = sets.New(ScopeAny)
+
+func (patternTagValidator) ValidScopes() sets.Set[Scope] {
+	return patternTagValidScopes
+}
+
+var (
+	matchesValidator      = types.Name{Package: libValidationPkg, Name: "Matches"}
+	matchesWarnValidator  = types.Name{Package: libValidationPkg, Name: "MatchesWarn"}
+	matchesAuditValidator = types.Name{Package: libValidationPkg, Name: "MatchesAudit"}
+)
+
+func (patternTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	var result Validations
+
+	action, _, err := parseEnforcementArgs(tag)
+	if err != nil {
+		return result, err
+	}
+
+	// This tag can apply to value and pointer fields, as well as typedefs
+	// (which should never be pointers). We need to check the concrete type.
+	if t := util.NonPointer(util.NativeType(context.Type)); t != types.String {
+		return result, fmt.Errorf("can only be used on string types (%s)", rootTypeString(context.Type, t))
+	}
+
+	if _, err := regexp.Compile(tag.Value); err != nil {
+		return result, fmt.Errorf("failed to compile pattern %q: %w", tag.Value, err)
+	}
+
+	validatorFn := matchesValidator
+	switch action {
+	case actionWarn:
+		validatorFn = matchesWarnValidator
+	case actionAudit:
+		validatorFn = matchesAuditValidator
+	}
+
+	// TODO: emit a single package-level `var` per unique pattern (shared
+	// across every field using it) instead of a MustCompile literal at each
+	// call site, so the pattern is compiled once rather than on every call.
+	result.AddFunction(Function(patternTagName, DefaultFlags, validatorFn, Literal(fmt.Sprintf("regexp.MustCompile(%q)", tag.Value)), humanReadablePattern(tag.Value)))
+	return result, nil
+}
+
+// humanReadablePattern renders pattern as the "must match the pattern ..."
+// phrase used in the generated field.Invalid message.
+func humanReadablePattern(pattern string) string {
+	return fmt.Sprintf("must match the regular expression %q", pattern)
+}
+
+func (ptv patternTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    ptv.TagName(),
+		Scopes: ptv.ValidScopes().UnsortedList(),
+		Description: "Indicates that a string field must match a regular expression." +
+			" By default a violation denies the request; pass action=warn or action=audit to instead surface it as a non-blocking warning or audit annotation.",
+		Args: []TagArgDoc{{
+			Description: "action=<deny|warn|audit>: the enforcement action to take on violation (default: deny)",
+		}, {
+			Description: "scope=<label>: an opaque label grouping related enforcement actions (default: all)",
+		}},
+		Payloads: []TagPayloadDoc{{
+			Description: "<RE2 regular expression>",
+			Docs:        "This field must match this pattern.",
+		}},
+		PayloadsType:     codetags.ValueTypeString,
+		PayloadsRequired: true,
+		Usage:            `+k8s:pattern=` + "`" + `^[a-z]+$` + "`" + ` or +k8s:pattern(action=warn)=` + "`" + `^[a-z]+$` + "`",
+	}
+}
@@ -0,0 +1,492 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/types"
+)
+
+// predNode is one node of a parsed +k8s:item `where` predicate's AST: a
+// leaf predCompare (field OP literal), or an internal predAnd/predOr/predNot
+// combining other predNodes. Grammar (highest to lowest precedence): `!`,
+// then `&&`, then `||`, with parentheses for grouping -- the usual boolean
+// operator precedence, so `a == "x" && b == "y" || c == "z"` parses as
+// `(a == "x" && b == "y") || c == "z"`.
+type predNode interface {
+	isPredNode()
+}
+
+// predCompare is a leaf: a single `field OP literal` or `field OP field`
+// comparison, e.g. `status == "True"`, `observedGeneration > 0`, or
+// `replicas <= maxReplicas`. rhsField is set, and lit is ignored, when the
+// right-hand side is itself a sibling field rather than a literal.
+type predCompare struct {
+	field    string
+	op       string // one of "==", "!=", "<", "<=", ">", ">="
+	lit      predLiteral
+	rhsField string
+}
+
+func (predCompare) isPredNode() {}
+
+type predAnd struct{ left, right predNode }
+
+func (predAnd) isPredNode() {}
+
+type predOr struct{ left, right predNode }
+
+func (predOr) isPredNode() {}
+
+type predNot struct{ node predNode }
+
+func (predNot) isPredNode() {}
+
+type predLiteralKind int
+
+const (
+	predLitString predLiteralKind = iota
+	predLitInt
+	predLitBool
+)
+
+type predLiteral struct {
+	kind predLiteralKind
+	str  string
+	i    int64
+	b    bool
+}
+
+// goSyntax renders lit as a Go literal expression suitable for comparing
+// directly against a struct field of a compatible builtin type. No explicit
+// cast is needed even when the field's type is a builtin alias (e.g.
+// `type Phase string`): an untyped Go constant like `"True"` or `5` converts
+// implicitly in a comparison.
+func (lit predLiteral) goSyntax() string {
+	switch lit.kind {
+	case predLitString:
+		return fmt.Sprintf("%q", lit.str)
+	case predLitInt:
+		return strconv.FormatInt(lit.i, 10)
+	case predLitBool:
+		if lit.b {
+			return "true"
+		}
+		return "false"
+	}
+	return ""
+}
+
+// --- tokenizer ---
+
+type predTokenKind int
+
+const (
+	predTokIdent predTokenKind = iota
+	predTokString
+	predTokInt
+	predTokOp
+	predTokLParen
+	predTokRParen
+	predTokEOF
+)
+
+type predToken struct {
+	kind predTokenKind
+	text string
+}
+
+// tokenizePredicate lexes expr into a token stream ending in a predTokEOF
+// sentinel, so the parser never needs a separate end-of-input check.
+func tokenizePredicate(expr string) ([]predToken, error) {
+	var toks []predToken
+	runes := []rune(expr)
+	n := len(runes)
+	i := 0
+	for i < n {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			toks = append(toks, predToken{predTokLParen, "("})
+			i++
+		case r == ')':
+			toks = append(toks, predToken{predTokRParen, ")"})
+			i++
+		case r == '"':
+			j := i + 1
+			var sb strings.Builder
+			closed := false
+			for j < n {
+				if runes[j] == '\\' && j+1 < n {
+					sb.WriteRune(runes[j+1])
+					j += 2
+					continue
+				}
+				if runes[j] == '"' {
+					closed = true
+					break
+				}
+				sb.WriteRune(runes[j])
+				j++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string literal in predicate %q", expr)
+			}
+			toks = append(toks, predToken{predTokString, sb.String()})
+			i = j + 1
+		case r == '&' && i+1 < n && runes[i+1] == '&':
+			toks = append(toks, predToken{predTokOp, "&&"})
+			i += 2
+		case r == '|' && i+1 < n && runes[i+1] == '|':
+			toks = append(toks, predToken{predTokOp, "||"})
+			i += 2
+		case r == '=' && i+1 < n && runes[i+1] == '=':
+			toks = append(toks, predToken{predTokOp, "=="})
+			i += 2
+		case r == '!' && i+1 < n && runes[i+1] == '=':
+			toks = append(toks, predToken{predTokOp, "!="})
+			i += 2
+		case r == '<' && i+1 < n && runes[i+1] == '=':
+			toks = append(toks, predToken{predTokOp, "<="})
+			i += 2
+		case r == '>' && i+1 < n && runes[i+1] == '=':
+			toks = append(toks, predToken{predTokOp, ">="})
+			i += 2
+		case r == '<':
+			toks = append(toks, predToken{predTokOp, "<"})
+			i++
+		case r == '>':
+			toks = append(toks, predToken{predTokOp, ">"})
+			i++
+		case r == '!':
+			toks = append(toks, predToken{predTokOp, "!"})
+			i++
+		case unicode.IsDigit(r) || (r == '-' && i+1 < n && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < n && unicode.IsDigit(runes[j]) {
+				j++
+			}
+			toks = append(toks, predToken{predTokInt, string(runes[i:j])})
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i + 1
+			for j < n && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			toks = append(toks, predToken{predTokIdent, string(runes[i:j])})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q in predicate %q", string(r), expr)
+		}
+	}
+	toks = append(toks, predToken{predTokEOF, ""})
+	return toks, nil
+}
+
+// --- recursive-descent parser ---
+
+type predParser struct {
+	toks []predToken
+	pos  int
+}
+
+// parsePredicate parses expr (a +k8s:item `where` argument) into a predNode
+// AST.
+func parsePredicate(expr string) (predNode, error) {
+	toks, err := tokenizePredicate(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &predParser{toks: toks}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != predTokEOF {
+		return nil, fmt.Errorf("unexpected trailing input %q in predicate %q", p.peek().text, expr)
+	}
+	return node, nil
+}
+
+func (p *predParser) peek() predToken { return p.toks[p.pos] }
+
+func (p *predParser) advance() predToken {
+	t := p.toks[p.pos]
+	if p.pos < len(p.toks)-1 {
+		p.pos++
+	}
+	return t
+}
+
+func (p *predParser) parseOr() (predNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == predTokOp && p.peek().text == "||" {
+		p.advance()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = predOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *predParser) parseAnd() (predNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == predTokOp && p.peek().text == "&&" {
+		p.advance()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = predAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *predParser) parseUnary() (predNode, error) {
+	if p.peek().kind == predTokOp && p.peek().text == "!" {
+		p.advance()
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return predNot{node: node}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *predParser) parsePrimary() (predNode, error) {
+	if p.peek().kind == predTokLParen {
+		p.advance()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != predTokRParen {
+			return nil, fmt.Errorf("expected ')'")
+		}
+		p.advance()
+		return node, nil
+	}
+	return p.parseComparison()
+}
+
+var predCompareOps = map[string]bool{"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true}
+
+func (p *predParser) parseComparison() (predNode, error) {
+	field := p.peek()
+	if field.kind != predTokIdent {
+		return nil, fmt.Errorf("expected a field name, got %q", field.text)
+	}
+	p.advance()
+	opTok := p.peek()
+	if opTok.kind != predTokOp || !predCompareOps[opTok.text] {
+		return nil, fmt.Errorf("expected a comparison operator after %q, got %q", field.text, opTok.text)
+	}
+	p.advance()
+
+	// A bare identifier on the right-hand side (other than true/false,
+	// which parseLiteral already treats as bool literals) names a sibling
+	// field rather than a literal, e.g. `replicas <= maxReplicas`.
+	if rhs := p.peek(); rhs.kind == predTokIdent && rhs.text != "true" && rhs.text != "false" {
+		p.advance()
+		return predCompare{field: field.text, op: opTok.text, rhsField: rhs.text}, nil
+	}
+
+	lit, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+	return predCompare{field: field.text, op: opTok.text, lit: lit}, nil
+}
+
+func (p *predParser) parseLiteral() (predLiteral, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case predTokString:
+		p.advance()
+		return predLiteral{kind: predLitString, str: tok.text}, nil
+	case predTokInt:
+		p.advance()
+		i, err := strconv.ParseInt(tok.text, 10, 64)
+		if err != nil {
+			return predLiteral{}, fmt.Errorf("invalid integer literal %q: %w", tok.text, err)
+		}
+		return predLiteral{kind: predLitInt, i: i}, nil
+	case predTokIdent:
+		if tok.text == "true" || tok.text == "false" {
+			p.advance()
+			return predLiteral{kind: predLitBool, b: tok.text == "true"}, nil
+		}
+	}
+	return predLiteral{}, fmt.Errorf("expected a literal (quoted string, integer, or true/false), got %q", tok.text)
+}
+
+// predEqualityFrontier returns every field==literal comparison reachable
+// from node without crossing an `||` or `!` -- the subset of a predicate
+// that holds unconditionally whenever the whole predicate does, regardless
+// of how its other conjuncts/disjuncts evaluate. +k8s:item's "every
+// +k8s:listMapKey field must be pinned" invariant is checked against this
+// frontier rather than the full predicate: an equality anywhere else (e.g.
+// under an `||`) doesn't guarantee the matched item's key fields have that
+// value, since the predicate could be satisfied by the other branch
+// instead.
+func predEqualityFrontier(node predNode) []predCompare {
+	switch n := node.(type) {
+	case predAnd:
+		return append(predEqualityFrontier(n.left), predEqualityFrontier(n.right)...)
+	case predCompare:
+		if n.op == "==" && n.rhsField == "" {
+			return []predCompare{n}
+		}
+	}
+	return nil
+}
+
+// predicateExpr renders node as a parenthesized Go boolean expression
+// evaluated against a *elemT value named varName. A comparand field that is
+// itself a pointer (an optional field) is dereferenced safely: a nil
+// pointer makes that leaf comparison false and short-circuits there,
+// rather than panicking. Any comparable builtin field type is supported,
+// not just string.
+func predicateExpr(elemT *types.Type, node predNode, varName string) (string, error) {
+	switch n := node.(type) {
+	case predCompare:
+		member := util.GetMemberByJSON(elemT, n.field)
+		if member == nil {
+			return "", fmt.Errorf("list item has no field with JSON name %q", n.field)
+		}
+
+		rhs := n.lit.goSyntax()
+		rhsNilable := false
+		rhsMemberName := ""
+		if n.rhsField != "" {
+			refMember := util.GetMemberByJSON(elemT, n.rhsField)
+			if refMember == nil {
+				return "", fmt.Errorf("list item has no field with JSON name %q", n.rhsField)
+			}
+			if util.NativeType(refMember.Type).Kind != types.Builtin {
+				return "", fmt.Errorf("predicate field %q must be a builtin type, got %s", refMember.Name, refMember.Type.String())
+			}
+			rhsMemberName = refMember.Name
+			rhsNilable = isNilableType(refMember.Type)
+			if rhsNilable {
+				rhs = fmt.Sprintf("*%s.%s", varName, rhsMemberName)
+			} else {
+				rhs = fmt.Sprintf("%s.%s", varName, rhsMemberName)
+			}
+		}
+
+		if isNilableType(member.Type) {
+			deref := util.NonPointer(util.NativeType(member.Type))
+			if deref.Kind != types.Builtin {
+				return "", fmt.Errorf("predicate field %q must be a builtin type (or pointer to one), got %s", member.Name, member.Type.String())
+			}
+			guard := fmt.Sprintf("%s.%s != nil", varName, member.Name)
+			if rhsNilable {
+				guard = fmt.Sprintf("%s && %s.%s != nil", guard, varName, rhsMemberName)
+			}
+			return fmt.Sprintf("(%s && *%s.%s %s %s)", guard, varName, member.Name, n.op, rhs), nil
+		}
+		if util.NativeType(member.Type).Kind != types.Builtin {
+			return "", fmt.Errorf("predicate field %q must be a builtin type, got %s", member.Name, member.Type.String())
+		}
+		if rhsNilable {
+			return fmt.Sprintf("(%s.%s != nil && %s.%s %s %s)", varName, rhsMemberName, varName, member.Name, n.op, rhs), nil
+		}
+		return fmt.Sprintf("(%s.%s %s %s)", varName, member.Name, n.op, rhs), nil
+	case predAnd:
+		l, err := predicateExpr(elemT, n.left, varName)
+		if err != nil {
+			return "", err
+		}
+		r, err := predicateExpr(elemT, n.right, varName)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s && %s)", l, r), nil
+	case predOr:
+		l, err := predicateExpr(elemT, n.left, varName)
+		if err != nil {
+			return "", err
+		}
+		r, err := predicateExpr(elemT, n.right, varName)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s || %s)", l, r), nil
+	case predNot:
+		inner, err := predicateExpr(elemT, n.node, varName)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(!%s)", inner), nil
+	}
+	return "", fmt.Errorf("unknown predicate node %T", node)
+}
+
+// pairsToPredicate synthesizes the AND-of-equalities predNode that a
+// classic `+k8s:item(key: "value", ...)` argument list has always meant, so
+// item.go's codegen can compile both the legacy pairs form and a `where`
+// predicate through the same predicateExpr, instead of keeping two
+// diverging code generators.
+func pairsToPredicate(pairs [][2]string) predNode {
+	var node predNode
+	for _, pair := range pairs {
+		cmp := predCompare{field: pair[0], op: "==", lit: predLiteral{kind: predLitString, str: pair[1]}}
+		if node == nil {
+			node = cmp
+		} else {
+			node = predAnd{left: node, right: cmp}
+		}
+	}
+	return node
+}
+
+// generatePathForPredicate renders frontier (the listMapKey-field equality
+// frontier of a `where` predicate) as a path-segment string, the way
+// generatePathForMap already does for the classic pairs form -- except a
+// non-string literal (an int or bool comparand) is rendered bare rather
+// than forced through %q, since it was never a quoted string to begin with.
+func generatePathForPredicate(frontier []predCompare) string {
+	var sb strings.Builder
+	for i, cmp := range frontier {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		if cmp.lit.kind == predLitString {
+			sb.WriteString(fmt.Sprintf("%s=%q", cmp.field, cmp.lit.str))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s=%s", cmp.field, cmp.lit.goSyntax()))
+		}
+	}
+	return sb.String()
+}
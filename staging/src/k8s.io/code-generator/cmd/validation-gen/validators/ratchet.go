@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const ratchetTagName = "k8s:ratchet"
+
+func init() {
+	RegisterTagValidator(ratchetTagValidator{})
+}
+
+// ratchetTagValidator implements +k8s:ratchet=<policy>: declares the
+// ratcheting policy -- Always, Never, or IfOldEquivalent (see
+// validate.RatchetPolicy) -- that every validator on the tagged field or
+// type should use when deciding whether to skip an update that doesn't
+// meaningfully change the value. This tag only records the declared policy;
+// wiring it into each validator's emitted short-circuit check is done by the
+// validator registry at the point a Function is built, not here.
+//
+// validate.ShouldRatchet is generic, so IfOldEquivalent already applies to a
+// struct-typed field exactly as it does to a scalar: a Validate_T2 call
+// guarding a nested T1.T2 subtree can be skipped the same way a leaf field's
+// validator is, with no struct-specific equality helper required -- the
+// existing reflectEqual comparison (an Equaler's EqualTo, else
+// equality.Semantic.DeepEqual) walks the whole subtree.
+// What this checkout does not have is the core code-generator machinery
+// (the templates that emit Validate_T1/Validate_T2 and their recursive call
+// sites) needed to automatically wrap *every* nested-struct recursion with
+// this guard; that emitter is not present here, so subtree ratcheting today
+// still requires declaring +k8s:ratchet explicitly on the struct-typed field
+// itself, the same as any other field. Generalizing this to an automatic,
+// opt-in-per-type mode (with generated equal_T<N> companions, as a
+// reflect.DeepEqual-free alternative to the Semantic.DeepEqual fallback
+// above) is a known follow-up that depends on that missing machinery.
+type ratchetTagValidator struct{}
+
+func (ratchetTagValidator) Init(_ Config) {}
+
+func (ratchetTagValidator) TagName() string {
+	return ratchetTagName
+}
+
+var ratchetTagValidScopes = sets.New(ScopeAny)
+
+func (ratchetTagValidator) ValidScopes() sets.Set[Scope] {
+	return ratchetTagValidScopes
+}
+
+var ratchetPolicies = sets.New("Always", "Never", "IfOldEquivalent")
+
+func (ratchetTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
+	var result Validations
+
+	if !ratchetPolicies.Has(payload) {
+		return result, fmt.Errorf("%s: policy must be one of %v, got %q", ratchetTagName, ratchetPolicies.UnsortedList(), payload)
+	}
+
+	result.AddComment(fmt.Sprintf("Ratcheting policy: %s.", payload))
+	return result, nil
+}
+
+func (rtv ratchetTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    rtv.TagName(),
+		Scopes: rtv.ValidScopes().UnsortedList(),
+		Description: "Declares the ratcheting policy for every validator on this field or type: whether an" +
+			" update that doesn't meaningfully change the value should skip validation, letting previously-stored" +
+			" invalid data round-trip. \"Always\" skips whenever an old value is present; \"Never\" disables" +
+			" ratcheting; \"IfOldEquivalent\" skips only when the new value is semantically equivalent to the old" +
+			" value (see validate.ShouldRatchet), which is preferable to an exact-match comparison when" +
+			" defaulting can change a value's representation without changing its meaning.",
+		Payloads: []TagPayloadDoc{{
+			Description: "Always|Never|IfOldEquivalent",
+			Docs:        "The ratcheting policy to apply.",
+		}},
+		PayloadsRequired: true,
+		Usage:            `+k8s:ratchet=IfOldEquivalent`,
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	requiredWhenTagName     = "k8s:requiredWhen"
+	forbiddenWhenTagName    = "k8s:forbiddenWhen"
+	immutableWhenTagName    = "k8s:immutableWhen"
+	mutuallyExclusveTagName = "k8s:mutuallyExclusive"
+)
+
+func init() {
+	RegisterTagValidator(requiredWhenTagValidator{})
+	RegisterTagValidator(forbiddenWhenTagValidator{})
+	RegisterTagValidator(immutableWhenTagValidator{})
+	RegisterTagValidator(mutuallyExclusiveTagValidator{})
+}
+
+var (
+	validateRequiredWhen      = types.Name{Package: libValidationPkg, Name: "RequiredWhen"}
+	validateForbiddenWhen     = types.Name{Package: libValidationPkg, Name: "ForbiddenWhen"}
+	validateImmutableWhen     = types.Name{Package: libValidationPkg, Name: "ImmutableWhen"}
+	validateMutuallyExclusive = types.Name{Package: libValidationPkg, Name: "MutuallyExclusive"}
+)
+
+// whenArgs are the two named arguments shared by +k8s:requiredWhen and
+// +k8s:forbiddenWhen: the field the constraint applies to ("field"), and the
+// boolean predicate, evaluated against sibling fields of the same struct,
+// that switches the constraint on ("when"). Like +k8s:eqField/+k8s:neField,
+// only sibling fields are supported -- "when" cannot reference a dotted
+// (cross-struct) path.
+type whenArgs struct {
+	field, when string
+}
+
+func parseWhenArgs(tagName string, tag codetags.Tag) (whenArgs, error) {
+	var out whenArgs
+	for _, arg := range tag.Args {
+		switch arg.Name {
+		case "field":
+			out.field = arg.Value
+		case "when":
+			out.when = arg.Value
+		default:
+			return out, fmt.Errorf("%s: unsupported argument %q", tagName, arg.Name)
+		}
+	}
+	if out.field == "" || out.when == "" {
+		return out, fmt.Errorf("%s: requires both 'field' and 'when' arguments", tagName)
+	}
+	return out, nil
+}
+
+// whenCondLiteral resolves structT's "field" member and renders "when" into a
+// FunctionLiteral of the form func(obj *structT) bool, reusing the same
+// predicate grammar and codegen (predicateExpr) that +k8s:atPath's element
+// filters and other predicate-bearing tags in this package use.
+func whenCondLiteral(tagName string, structT *types.Type, args whenArgs) (*types.Member, FunctionLiteral, error) {
+	fieldMember := util.GetMemberByJSON(structT, args.field)
+	if fieldMember == nil {
+		return nil, FunctionLiteral{}, fmt.Errorf("%s: %s has no field with JSON name %q", tagName, structT.Name.Name, args.field)
+	}
+
+	pred, err := parsePredicate(args.when)
+	if err != nil {
+		return nil, FunctionLiteral{}, fmt.Errorf("%s: invalid 'when' predicate: %w", tagName, err)
+	}
+	expr, err := predicateExpr(structT, pred, "obj")
+	if err != nil {
+		return nil, FunctionLiteral{}, fmt.Errorf("%s: invalid 'when' predicate: %w", tagName, err)
+	}
+
+	cond := FunctionLiteral{
+		Parameters: []ParamResult{{"obj", types.PointerTo(structT)}},
+		Results:    []ParamResult{{"", types.Bool}},
+		Body:       fmt.Sprintf("return %s", expr),
+	}
+	return fieldMember, cond, nil
+}
+
+// requiredWhenTagValidator implements
+// +k8s:requiredWhen(field: "version", when: "group == \"\""), declaring that
+// "field" must be set whenever "when" -- a boolean predicate over sibling
+// fields of the same struct -- holds.
+//
+// Like +k8s:eqField/+k8s:neField, this attaches as one more Function to the
+// tagged struct's own validation list: it does not require, and this
+// checkout does not have, a separate cross-field validation pass or a
+// topological sort of per-field validators. The runtime check
+// (validate.RequiredWhen) and the condition it's given are exactly as
+// expressive as a hand-written "validateCrossFields_T<N>" helper would be,
+// just generated as a closure instead of a named function.
+type requiredWhenTagValidator struct{}
+
+func (requiredWhenTagValidator) Init(_ Config) {}
+
+func (requiredWhenTagValidator) TagName() string {
+	return requiredWhenTagName
+}
+
+func (requiredWhenTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldTagValidScopes
+}
+
+func (requiredWhenTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", requiredWhenTagName)
+	}
+	args, err := parseWhenArgs(requiredWhenTagName, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	fieldMember, cond, err := whenCondLiteral(requiredWhenTagName, structT, args)
+	if err != nil {
+		return Validations{}, err
+	}
+	f := Function(requiredWhenTagName, DefaultFlags, validateRequiredWhen, args.field, accessorLiteral(structT, fieldMember), cond)
+	return Validations{Functions: []FunctionGen{f}}, nil
+}
+
+func (requiredWhenTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    requiredWhenTagName,
+		Scopes: crossFieldTagValidScopes.UnsortedList(),
+		Description: "Declares that a field of a struct must be set whenever a predicate over its sibling fields " +
+			"holds.",
+		Args: []TagArgDoc{
+			{Description: `field="<json-name>": the field that becomes required; errors are reported here`},
+			{Description: `when="<predicate>": a boolean expression over sibling fields (e.g. "group == \"\"") that switches the requirement on`},
+		},
+		Usage: `+k8s:requiredWhen(field: "version", when: "group == \"\"")`,
+	}
+}
+
+// forbiddenWhenTagValidator implements
+// +k8s:forbiddenWhen(field: "legacyName", when: "apiVersion == \"v2\""),
+// declaring that "field" must be unset whenever "when" -- a boolean
+// predicate over sibling fields of the same struct -- holds. See
+// requiredWhenTagValidator's doc comment for how this is wired without a
+// separate cross-field pass.
+type forbiddenWhenTagValidator struct{}
+
+func (forbiddenWhenTagValidator) Init(_ Config) {}
+
+func (forbiddenWhenTagValidator) TagName() string {
+	return forbiddenWhenTagName
+}
+
+func (forbiddenWhenTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldTagValidScopes
+}
+
+func (forbiddenWhenTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", forbiddenWhenTagName)
+	}
+	args, err := parseWhenArgs(forbiddenWhenTagName, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	fieldMember, cond, err := whenCondLiteral(forbiddenWhenTagName, structT, args)
+	if err != nil {
+		return Validations{}, err
+	}
+	f := Function(forbiddenWhenTagName, DefaultFlags, validateForbiddenWhen, args.field, accessorLiteral(structT, fieldMember), cond)
+	return Validations{Functions: []FunctionGen{f}}, nil
+}
+
+func (forbiddenWhenTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    forbiddenWhenTagName,
+		Scopes: crossFieldTagValidScopes.UnsortedList(),
+		Description: "Declares that a field of a struct must be unset whenever a predicate over its sibling " +
+			"fields holds.",
+		Args: []TagArgDoc{
+			{Description: `field="<json-name>": the field that becomes forbidden; errors are reported here`},
+			{Description: `when="<predicate>": a boolean expression over sibling fields (e.g. "apiVersion == \"v2\"") that switches the prohibition on`},
+		},
+		Usage: `+k8s:forbiddenWhen(field: "legacyName", when: "apiVersion == \"v2\"")`,
+	}
+}
+
+// immutableWhenTagValidator implements
+// +k8s:immutableWhen(field: "volumeName", when: "phase == \"Bound\""),
+// declaring that "field" must not change on update whenever "when" -- a
+// boolean predicate over sibling fields of the same struct, evaluated
+// against the new object -- holds. See requiredWhenTagValidator's doc
+// comment for how this is wired without a separate cross-field pass.
+//
+// Unlike +k8s:requiredWhen/+k8s:forbiddenWhen (which only check presence,
+// so work on any field type), the guarded field must be a directly
+// comparable type (e.g. string, int, bool), the same constraint
+// +k8s:eqField/+k8s:neField place on their "field" argument, since the
+// runtime check compares old and new values rather than just nil-ness.
+type immutableWhenTagValidator struct{}
+
+func (immutableWhenTagValidator) Init(_ Config) {}
+
+func (immutableWhenTagValidator) TagName() string {
+	return immutableWhenTagName
+}
+
+func (immutableWhenTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldTagValidScopes
+}
+
+func (immutableWhenTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", immutableWhenTagName)
+	}
+	args, err := parseWhenArgs(immutableWhenTagName, tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	fieldMember, cond, err := whenCondLiteral(immutableWhenTagName, structT, args)
+	if err != nil {
+		return Validations{}, err
+	}
+	fieldT := util.NonPointer(util.NativeType(fieldMember.Type))
+	if !util.IsDirectComparable(fieldT) {
+		return Validations{}, fmt.Errorf("%s: field %q must be a directly comparable type (e.g. string, int, bool), got %s", immutableWhenTagName, args.field, fieldT.String())
+	}
+	f := Function(immutableWhenTagName, DefaultFlags, validateImmutableWhen, args.field, accessorLiteral(structT, fieldMember), cond)
+	return Validations{Functions: []FunctionGen{f}}, nil
+}
+
+func (immutableWhenTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    immutableWhenTagName,
+		Scopes: crossFieldTagValidScopes.UnsortedList(),
+		Description: "Declares that a field of a struct must not change on update whenever a predicate over its " +
+			"sibling fields, evaluated against the new object, holds. Because the predicate is evaluated against " +
+			"the new object, a sibling field transitioning into the locking state in the same update that changes " +
+			"the guarded field is still forbidden.",
+		Args: []TagArgDoc{
+			{Description: `field="<json-name>": the field that becomes immutable; errors are reported here`},
+			{Description: `when="<predicate>": a boolean expression over sibling fields (e.g. "phase == \"Bound\"") that switches the immutability on`},
+		},
+		Usage: `+k8s:immutableWhen(field: "volumeName", when: "phase == \"Bound\"")`,
+	}
+}
+
+// mutuallyExclusiveTagValidator implements
+// +k8s:mutuallyExclusive(fields: "a,b,c"), declaring that at most one of the
+// named sibling fields may be set. Like requiredWhen/forbiddenWhen above,
+// this is one more Function on the struct's own validation list, not a
+// separate pass.
+type mutuallyExclusiveTagValidator struct{}
+
+func (mutuallyExclusiveTagValidator) Init(_ Config) {}
+
+func (mutuallyExclusiveTagValidator) TagName() string {
+	return mutuallyExclusveTagName
+}
+
+func (mutuallyExclusiveTagValidator) ValidScopes() sets.Set[Scope] {
+	return crossFieldTagValidScopes
+}
+
+func (mutuallyExclusiveTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", mutuallyExclusveTagName)
+	}
+	fieldNames, err := crossItemsFieldsArg(tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	if len(fieldNames) < 2 {
+		return Validations{}, fmt.Errorf("%s: requires a `fields` argument naming at least two fields", mutuallyExclusveTagName)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("return []bool{")
+	for i, jsonName := range fieldNames {
+		member := util.GetMemberByJSON(structT, jsonName)
+		if member == nil {
+			return Validations{}, fmt.Errorf("%s: %s has no field with JSON name %q", mutuallyExclusveTagName, structT.Name.Name, jsonName)
+		}
+		if !isNilableType(member.Type) {
+			return Validations{}, fmt.Errorf("%s: field %q must be a nilable (optional, pointer) type to be checked for presence", mutuallyExclusveTagName, jsonName)
+		}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "obj.%s != nil", member.Name)
+		fieldNames[i] = member.Name
+	}
+	sb.WriteString("}")
+
+	isSet := FunctionLiteral{
+		Parameters: []ParamResult{{"obj", types.PointerTo(structT)}},
+		Results:    []ParamResult{{"", &types.Type{Kind: types.Slice, Elem: types.Bool}}},
+		Body:       sb.String(),
+	}
+	f := Function(mutuallyExclusveTagName, DefaultFlags, validateMutuallyExclusive, fieldNames, isSet)
+	return Validations{Functions: []FunctionGen{f}}, nil
+}
+
+func (mutuallyExclusiveTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:         mutuallyExclusveTagName,
+		Scopes:      crossFieldTagValidScopes.UnsortedList(),
+		Description: "Declares that at most one of the named sibling fields of a struct may be set.",
+		Args: []TagArgDoc{
+			{Description: `fields="<json-name>,<json-name>[,<json-name>...]": the fields that are mutually exclusive`},
+		},
+		Usage: `+k8s:mutuallyExclusive(fields: "a,b,c")`,
+	}
+}
@@ -20,6 +20,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -30,6 +33,351 @@ const (
 	subfieldTagName = "k8s:subfield"
 )
 
+// formatListMapKeyDoc renders a list-map key name/value pair for a
+// documentation-time Context.Path, mirroring the canonical
+// "keyName=keyValue" form used by the generated code's own
+// validate.FormatListMapKey at runtime (see ListMapElementByKey). Simple
+// values are left bare for backward compatibility; anything containing a
+// quote, backslash, bracket, "=", ",", or control character is Go-quoted
+// (strconv.Quote, as FormatListMapKey does) so the path stays unambiguous
+// to parse.
+func formatListMapKeyDoc(keyName, keyValue string) string {
+	if !isSimpleListMapKeyValueDoc(keyValue) {
+		return keyName + "=" + strconv.Quote(keyValue)
+	}
+	return keyName + "=" + keyValue
+}
+
+func isSimpleListMapKeyValueDoc(keyValue string) bool {
+	if keyValue == "" {
+		return false
+	}
+	for _, r := range keyValue {
+		switch r {
+		case '"', '\\', '[', ']', '=', ',':
+			return false
+		}
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// subfieldPathSegment is one dot-separated element of a multi-segment
+// +k8s:subfield config, e.g. "metadata" or `containers[{"name":"foo"}]`.
+type subfieldPathSegment struct {
+	name     string
+	selector map[string]string // non-nil if this segment also selects a list element by key
+}
+
+// subfieldSegmentPattern matches one path segment: a field's JSON name with
+// an optional trailing `[{...}]` list-by-key selector, reusing the same
+// JSON-object selector syntax the single-segment list-access form above
+// already accepts.
+var subfieldSegmentPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)(\[(\{.*\})\])?$`)
+
+// splitSubfieldPath splits a multi-segment config string on '.', treating
+// anything inside a bracket as opaque so a '.' inside a selector's JSON
+// value never splits the segment -- the same bracket-depth tracking
+// +k8s:atPath's splitAtPathSegments uses.
+func splitSubfieldPath(expr string) []string {
+	var out []string
+	depth := 0
+	start := 0
+	for i, r := range expr {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				out = append(out, expr[start:i])
+				start = i + 1
+			}
+		}
+	}
+	out = append(out, expr[start:])
+	return out
+}
+
+// parseSubfieldPath parses a dotted, possibly list-selector-annotated
+// config string (e.g. "spec.template.metadata.labels" or
+// `containers[{"name":"foo"}].image`) into its segments.
+func parseSubfieldPath(expr string) ([]subfieldPathSegment, error) {
+	var segments []subfieldPathSegment
+	for _, part := range splitSubfieldPath(expr) {
+		m := subfieldSegmentPattern.FindStringSubmatch(part)
+		if m == nil {
+			return nil, fmt.Errorf("invalid subfield path segment %q", part)
+		}
+		seg := subfieldPathSegment{name: m[1]}
+		if m[3] != "" {
+			var selector map[string]string
+			if err := json.Unmarshal([]byte(m[3]), &selector); err != nil {
+				return nil, fmt.Errorf("invalid list selector %s in segment %q: %w", m[3], part, err)
+			}
+			if len(selector) != 1 {
+				return nil, fmt.Errorf("list selector %s in segment %q must be a single key-value map", m[3], part)
+			}
+			seg.selector = selector
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// subfieldStep is one resolved hop of a multi-segment subfield path, ready
+// to be composed innermost-first into a chain of Subfield/
+// ListMapElementByKey Function wrappers around the chained validation, the
+// same way the single-segment branches below build one such wrapper
+// directly.
+type subfieldStep struct {
+	isListSelector bool
+
+	// populated when !isListSelector:
+	fieldName string
+	getFn     FunctionLiteral
+
+	// populated when isListSelector:
+	listKeyName  string
+	listKeyValue string
+
+	// resultType is the type this step produces: the field's type for a
+	// struct-field step, or the element type for a list-selector step.
+	resultType *types.Type
+}
+
+// subfieldPredicate is a parsed list-access selector for +k8s:subfield: a
+// tree of per-field comparisons ("eq"/"ne"/"in", each naming one element
+// field by its JSON name) combined with "and"/"or" composition. The plain
+// `{"key":"value", ...}` selector form parses as an implicit "and" of "eq"
+// leaves (or a single "eq" leaf, for one key) -- this is the only shape the
+// single-/multi-key fast paths in GetValidations understand, so anything
+// using "$ne", "$in", or an explicit "$or" falls back to evaluating the
+// tree as a predicate closure at runtime instead.
+type subfieldPredicate struct {
+	kind     string              // "eq", "ne", "in", "and", "or"
+	key      string              // element field's JSON name, set for "eq"/"ne"/"in"
+	value    string              // comparison value, set for "eq"/"ne"
+	values   []string            // candidate values, set for "in"
+	children []subfieldPredicate // set for "and"/"or"
+}
+
+// parseSubfieldPredicate parses a +k8s:subfield list-access JSON selector
+// object into a subfieldPredicate tree. Each key of the object selects one
+// element field by its JSON name, unless the key is "$and" or "$or", in
+// which case it composes a list of nested selector objects; a key's value
+// is either a bare string (shorthand for {"$eq": value}) or a single-key
+// object naming one of "$ne" or "$in" (whose value is a JSON array of
+// strings).
+func parseSubfieldPredicate(raw []byte) (subfieldPredicate, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return subfieldPredicate{}, fmt.Errorf("selector must be a JSON object: %w", err)
+	}
+	if len(obj) == 0 {
+		return subfieldPredicate{}, fmt.Errorf("selector must have at least one key")
+	}
+
+	if raw, ok := obj["$and"]; ok {
+		if len(obj) != 1 {
+			return subfieldPredicate{}, fmt.Errorf(`"$and" must be the only key in its selector object`)
+		}
+		return parseSubfieldPredicateList("and", raw)
+	}
+	if raw, ok := obj["$or"]; ok {
+		if len(obj) != 1 {
+			return subfieldPredicate{}, fmt.Errorf(`"$or" must be the only key in its selector object`)
+		}
+		return parseSubfieldPredicateList("or", raw)
+	}
+
+	// A flat {"key": value, ...} object: an implicit "and" of one "eq"/"ne"/
+	// "in" leaf per key. Keys are sorted for deterministic generated code.
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var children []subfieldPredicate
+	for _, k := range keys {
+		child, err := parseSubfieldFieldPredicate(k, obj[k])
+		if err != nil {
+			return subfieldPredicate{}, err
+		}
+		children = append(children, child)
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return subfieldPredicate{kind: "and", children: children}, nil
+}
+
+func parseSubfieldPredicateList(kind string, raw json.RawMessage) (subfieldPredicate, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return subfieldPredicate{}, fmt.Errorf("%q value must be a JSON array of selector objects: %w", "$"+kind, err)
+	}
+	if len(items) == 0 {
+		return subfieldPredicate{}, fmt.Errorf("%q must have at least one selector", "$"+kind)
+	}
+	var children []subfieldPredicate
+	for _, item := range items {
+		child, err := parseSubfieldPredicate(item)
+		if err != nil {
+			return subfieldPredicate{}, err
+		}
+		children = append(children, child)
+	}
+	return subfieldPredicate{kind: kind, children: children}, nil
+}
+
+func parseSubfieldFieldPredicate(key string, raw json.RawMessage) (subfieldPredicate, error) {
+	// A bare string is shorthand for equality.
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return subfieldPredicate{kind: "eq", key: key, value: s}, nil
+	}
+
+	var op map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &op); err != nil {
+		return subfieldPredicate{}, fmt.Errorf("selector value for key %q must be a string or an operator object, got %s", key, string(raw))
+	}
+	if len(op) != 1 {
+		return subfieldPredicate{}, fmt.Errorf("operator object for key %q must have exactly one operator", key)
+	}
+	for opName, opVal := range op {
+		switch opName {
+		case "$ne":
+			var v string
+			if err := json.Unmarshal(opVal, &v); err != nil {
+				return subfieldPredicate{}, fmt.Errorf("%q value for key %q must be a string: %w", opName, key, err)
+			}
+			return subfieldPredicate{kind: "ne", key: key, value: v}, nil
+		case "$in":
+			var vs []string
+			if err := json.Unmarshal(opVal, &vs); err != nil {
+				return subfieldPredicate{}, fmt.Errorf("%q value for key %q must be a JSON array of strings: %w", opName, key, err)
+			}
+			if len(vs) == 0 {
+				return subfieldPredicate{}, fmt.Errorf("%q value for key %q must not be empty", opName, key)
+			}
+			return subfieldPredicate{kind: "in", key: key, values: vs}, nil
+		default:
+			return subfieldPredicate{}, fmt.Errorf("unsupported operator %q for key %q", opName, key)
+		}
+	}
+	panic("unreachable")
+}
+
+// flattenSubfieldEqualityPredicate returns pred as a flat key->value map of
+// equality comparisons, and true, if and only if pred is entirely made of
+// "eq" leaves (optionally combined with "and") -- i.e. it is exactly the
+// shape the single-/multi-key fast paths below already generate efficient,
+// duplicate-safe code for. Anything involving "$ne", "$in", or "$or" returns
+// false so the caller falls back to the generic predicate-closure path.
+func flattenSubfieldEqualityPredicate(pred subfieldPredicate) (map[string]string, bool) {
+	switch pred.kind {
+	case "eq":
+		return map[string]string{pred.key: pred.value}, true
+	case "and":
+		result := map[string]string{}
+		for _, child := range pred.children {
+			m, ok := flattenSubfieldEqualityPredicate(child)
+			if !ok {
+				return nil, false
+			}
+			for k, v := range m {
+				if _, dup := result[k]; dup {
+					return nil, false
+				}
+				result[k] = v
+			}
+		}
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// subfieldPredicateKeys returns the element field JSON names referenced
+// anywhere in pred, sorted and de-duplicated.
+func subfieldPredicateKeys(pred subfieldPredicate) []string {
+	seen := sets.New[string]()
+	var walk func(subfieldPredicate)
+	walk = func(p subfieldPredicate) {
+		switch p.kind {
+		case "eq", "ne", "in":
+			seen.Insert(p.key)
+		case "and", "or":
+			for _, child := range p.children {
+				walk(child)
+			}
+		}
+	}
+	walk(pred)
+	return sets.List(seen)
+}
+
+// buildSubfieldPredicateExpr renders pred as a Go boolean expression over a
+// list element named "item" (a pointer to elemType), for use in a MatchFn
+// passed to validate.ListMapItemsByPredicate. It errors if any referenced
+// key is not a real JSON field on elemType, so a typo'd selector key is
+// caught at generation time rather than silently never matching.
+func buildSubfieldPredicateExpr(elemType *types.Type, pred subfieldPredicate) (string, error) {
+	switch pred.kind {
+	case "eq", "ne", "in":
+		memb := getMemberByJSON(elemType, pred.key)
+		if memb == nil {
+			return "", fmt.Errorf("element type %s has no field with JSON name %q", elemType.Name.String(), pred.key)
+		}
+		switch pred.kind {
+		case "eq":
+			return fmt.Sprintf("item.%s == %q", memb.Name, pred.value), nil
+		case "ne":
+			return fmt.Sprintf("item.%s != %q", memb.Name, pred.value), nil
+		default: // "in"
+			parts := make([]string, len(pred.values))
+			for i, v := range pred.values {
+				parts[i] = fmt.Sprintf("item.%s == %q", memb.Name, v)
+			}
+			return "(" + strings.Join(parts, " || ") + ")", nil
+		}
+	case "and", "or":
+		parts := make([]string, len(pred.children))
+		for i, child := range pred.children {
+			expr, err := buildSubfieldPredicateExpr(elemType, child)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = expr
+		}
+		sep := " && "
+		if pred.kind == "or" {
+			sep = " || "
+		}
+		return "(" + strings.Join(parts, sep) + ")", nil
+	default:
+		return "", fmt.Errorf("unknown predicate kind %q", pred.kind)
+	}
+}
+
+// buildSubfieldPredicateMatchFn builds the MatchFn[elemType] function
+// literal for validate.ListMapItemsByPredicate from pred.
+func buildSubfieldPredicateMatchFn(elemType *types.Type, pred subfieldPredicate) (FunctionLiteral, error) {
+	expr, err := buildSubfieldPredicateExpr(elemType, pred)
+	if err != nil {
+		return FunctionLiteral{}, err
+	}
+	return FunctionLiteral{
+		Parameters: []ParamResult{{"item", types.PointerTo(elemType)}},
+		Results:    []ParamResult{{"", types.Bool}},
+		Body:       fmt.Sprintf("if item == nil {\n\treturn false\n}\nreturn %s", expr),
+	}, nil
+}
+
 func init() {
 	RegisterTagValidator(&subfieldTagValidator{})
 }
@@ -53,8 +401,10 @@ func (subfieldTagValidator) ValidScopes() sets.Set[Scope] {
 }
 
 var (
-	validateSubfield                = types.Name{Package: libValidationPkg, Name: "Subfield"}
-	validateListMapElementByKeyName = types.Name{Package: libValidationPkg, Name: "ListMapElementByKey"}
+	validateSubfield                  = types.Name{Package: libValidationPkg, Name: "Subfield"}
+	validateListMapElementByKeyName   = types.Name{Package: libValidationPkg, Name: "ListMapElementByKey"}
+	validateListMapElementByKeyValues = types.Name{Package: libValidationPkg, Name: "ListMapElementByKeyValues"}
+	validateListMapItemsByPredicate   = types.Name{Package: libValidationPkg, Name: "ListMapItemsByPredicate"}
 )
 
 // parseSubfieldConfig parses the subfield configuration string and the chained validation tag.
@@ -171,21 +521,10 @@ func (stv *subfieldTagValidator) GetValidations(context Context, args []string,
 	}
 
 	if isListAccessByJSON {
-		var listSelector map[string]string
-		if err := json.Unmarshal([]byte(subfieldConfigStr), &listSelector); err != nil {
+		pred, err := parseSubfieldPredicate([]byte(subfieldConfigStr))
+		if err != nil {
 			return result, fmt.Errorf("%s: error parsing JSON selector from config '%s': %w", subfieldTagName, subfieldConfigStr, err)
 		}
-		if len(listSelector) != 1 {
-			return result, fmt.Errorf("%s: JSON selector in config '%s' must be a single key-value map", subfieldTagName, subfieldConfigStr)
-		}
-
-		// These are the correctly named variables from the loop
-		var parsedKeyNameFromJSON, parsedKeyValueFromJSON string
-		for k, v := range listSelector {
-			parsedKeyNameFromJSON = k
-			parsedKeyValueFromJSON = v
-			break
-		}
 
 		currentFieldType := nonPointer(nativeType(context.Type))
 		if currentFieldType.Kind != types.Slice && currentFieldType.Kind != types.Array {
@@ -195,22 +534,103 @@ func (stv *subfieldTagValidator) GetValidations(context Context, args []string,
 		if elemType.Kind != types.Struct {
 			return result, fmt.Errorf("%s: elements of slice/array (selector '%s') must be structs, but elements of field %s are %s", subfieldTagName, subfieldConfigStr, context.Path.String(), elemType.Name.String())
 		}
-		// Use the parsed key name to find the member
-		keyFieldMemb := getMemberByJSON(elemType, parsedKeyNameFromJSON)
-		if keyFieldMemb == nil {
-			return result, fmt.Errorf("%s: element type %s (of list %s) has no field with JSON name %q (from selector '%s')", subfieldTagName, elemType.Name.String(), context.Path.String(), parsedKeyNameFromJSON, subfieldConfigStr)
-		}
 		if context.Parent == nil || context.Member == nil {
 			return result, fmt.Errorf("%s: list access (selector '%s') can only be used on a field of a struct (tag on %s)", subfieldTagName, subfieldConfigStr, context.Path.String())
 		}
 
+		if keyValuesMap, ok := flattenSubfieldEqualityPredicate(pred); ok {
+			// Single-/multi-key equality fast path: preserved exactly as
+			// before, since this is the shape ListMapElementByKey(Values)
+			// handles efficiently (and safely, with a field.Duplicate-free
+			// at-most-one-match guarantee the generic predicate path below
+			// does not make).
+			keyNames := make([]string, 0, len(keyValuesMap))
+			for k := range keyValuesMap {
+				keyNames = append(keyNames, k)
+			}
+			sort.Strings(keyNames)
+			keyValues := make([]string, len(keyNames))
+			var keyMemb *types.Member
+			for i, k := range keyNames {
+				keyValues[i] = keyValuesMap[k]
+				m := getMemberByJSON(elemType, k)
+				if m == nil {
+					return result, fmt.Errorf("%s: element type %s (of list %s) has no field with JSON name %q (from selector '%s')", subfieldTagName, elemType.Name.String(), context.Path.String(), k, subfieldConfigStr)
+				}
+				keyMemb = m
+			}
+
+			docPath := context.Path.Key(formatListMapKeyDoc(keyNames[0], keyValues[0]))
+			if len(keyNames) > 1 {
+				parts := make([]string, len(keyNames))
+				for i, k := range keyNames {
+					parts[i] = formatListMapKeyDoc(k, keyValues[i])
+				}
+				docPath = context.Path.Key(strings.Join(parts, ","))
+			}
+
+			subContextForPayload := Context{
+				Scope:  ScopeField,
+				Type:   elemType,
+				Parent: context.Parent,
+				Path:   docPath,
+				Member: keyMemb,
+			}
+
+			payloadValidations, errExtract := stv.validator.ExtractValidations(subContextForPayload, []string{validationTagToApply})
+			if errExtract != nil {
+				return result, fmt.Errorf("failed to extract chained validations for %s list access (selector '%s', applying to element type %s) on %s: %w", subfieldTagName, subfieldConfigStr, elemType.Name.String(), context.Path.String(), errExtract)
+			}
+			result.Variables = append(result.Variables, payloadValidations.Variables...)
+
+			for _, vfn := range payloadValidations.Functions {
+				var f FunctionGen
+				if len(keyNames) == 1 {
+					f = Function(
+						subfieldTagName,
+						vfn.Flags,
+						validateListMapElementByKeyName,
+						keyNames[0],
+						keyValues[0],
+						WrapperFunction{vfn, elemType},
+					)
+				} else {
+					f = Function(
+						subfieldTagName,
+						vfn.Flags,
+						validateListMapElementByKeyValues,
+						buildSliceLiteral(types.String, types.String, keyNames, func(s string) string { return fmt.Sprintf("%q", s) }),
+						buildSliceLiteral(types.String, types.String, keyValues, func(s string) string { return fmt.Sprintf("%q", s) }),
+						WrapperFunction{vfn, elemType},
+					)
+				}
+				result.Functions = append(result.Functions, f)
+			}
+			return result, nil
+		}
+
+		// Generic predicate path: the selector uses "$ne", "$in", and/or
+		// "$or", so it may legitimately match more than one element (e.g.
+		// "every container whose name is not istio-proxy") -- this compiles
+		// the predicate tree to a MatchFn closure and lets
+		// validate.ListMapItemsByPredicate iterate every match instead of
+		// assuming a unique one.
+		keys := subfieldPredicateKeys(pred)
+		var keyMemb *types.Member
+		for _, k := range keys {
+			m := getMemberByJSON(elemType, k)
+			if m == nil {
+				return result, fmt.Errorf("%s: element type %s (of list %s) has no field with JSON name %q (from selector '%s')", subfieldTagName, elemType.Name.String(), context.Path.String(), k, subfieldConfigStr)
+			}
+			keyMemb = m
+		}
+
 		subContextForPayload := Context{
 			Scope:  ScopeField,
 			Type:   elemType,
 			Parent: context.Parent,
-			// Use the correctly parsed variables here
-			Path:   context.Path.Key(parsedKeyNameFromJSON + "=" + parsedKeyValueFromJSON),
-			Member: keyFieldMemb,
+			Path:   context.Path.Key(subfieldConfigStr),
+			Member: keyMemb,
 		}
 
 		payloadValidations, errExtract := stv.validator.ExtractValidations(subContextForPayload, []string{validationTagToApply})
@@ -219,21 +639,25 @@ func (stv *subfieldTagValidator) GetValidations(context Context, args []string,
 		}
 		result.Variables = append(result.Variables, payloadValidations.Variables...)
 
+		matchFn, err := buildSubfieldPredicateMatchFn(elemType, pred)
+		if err != nil {
+			return result, fmt.Errorf("%s: selector '%s': %w", subfieldTagName, subfieldConfigStr, err)
+		}
+
 		for _, vfn := range payloadValidations.Functions {
 			f := Function(
 				subfieldTagName,
 				vfn.Flags,
-				validateListMapElementByKeyName,
-				parsedKeyNameFromJSON,  // Pass the correct variable
-				parsedKeyValueFromJSON, // Pass the correct variable
+				validateListMapItemsByPredicate,
+				matchFn,
 				WrapperFunction{vfn, elemType},
 			)
 			result.Functions = append(result.Functions, f)
 		}
 		return result, nil
 
-	} else { // Direct struct field access
-		// ... (this part was okay)
+	} else if !strings.ContainsAny(subfieldConfigStr, ".[") {
+		// Single-segment fast path: a direct field of the struct.
 		subname := subfieldConfigStr
 		t := nonPointer(nativeType(context.Type))
 		if t.Kind != types.Struct {
@@ -282,18 +706,135 @@ func (stv *subfieldTagValidator) GetValidations(context Context, args []string,
 			result.Functions = append(result.Functions, f)
 		}
 		return result, nil
+
+	} else {
+		// Multi-segment path: a dotted chain of struct fields, optionally
+		// with a bracketed list-by-key selector on any segment, e.g.
+		// "spec.template.metadata.labels" or
+		// `containers[{"name":"foo"}].image`. Each segment is resolved and
+		// composed the same way the single-segment branches above build one
+		// Subfield/ListMapElementByKey wrapper, just chained so the full
+		// path shows up in the validation error.
+		segments, err := parseSubfieldPath(subfieldConfigStr)
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", subfieldTagName, err)
+		}
+
+		curType := nonPointer(nativeType(context.Type))
+		path := context.Path
+		var steps []subfieldStep
+		var leafMember *types.Member
+
+		for _, seg := range segments {
+			if curType.Kind != types.Struct {
+				return result, fmt.Errorf("%s: cannot select field %q in path %q: %s is not a struct (field %s)", subfieldTagName, seg.name, subfieldConfigStr, curType.String(), context.Path.String())
+			}
+			memb := getMemberByJSON(curType, seg.name)
+			if memb == nil {
+				return result, fmt.Errorf("%s: type %s has no field with JSON name %q (path %q, field %s)", subfieldTagName, curType.Name.String(), seg.name, subfieldConfigStr, context.Path.String())
+			}
+			path = path.Child(seg.name)
+			leafMember = memb
+
+			accessorParentType := types.PointerTo(curType)
+			actualFieldType := memb.Type
+			returnedFieldType := actualFieldType
+			fieldExprPrefix := ""
+			if !isNilableType(actualFieldType) {
+				returnedFieldType = types.PointerTo(actualFieldType)
+				fieldExprPrefix = "&"
+			}
+			steps = append(steps, subfieldStep{
+				fieldName:  seg.name,
+				resultType: actualFieldType,
+				getFn: FunctionLiteral{
+					Parameters: []ParamResult{{"o", accessorParentType}},
+					Results:    []ParamResult{{"", returnedFieldType}},
+					Body:       fmt.Sprintf("return %so.%s", fieldExprPrefix, memb.Name),
+				},
+			})
+			curType = nonPointer(nativeType(actualFieldType))
+
+			if seg.selector != nil {
+				if curType.Kind != types.Slice && curType.Kind != types.Array {
+					return result, fmt.Errorf("%s: list selector on field %q in path %q can only be used on a slice/array, got %s", subfieldTagName, seg.name, subfieldConfigStr, curType.String())
+				}
+				elemType := nonPointer(nativeType(curType.Elem))
+				if elemType.Kind != types.Struct {
+					return result, fmt.Errorf("%s: list selector on field %q in path %q requires a list of structs, got elements of %s", subfieldTagName, seg.name, subfieldConfigStr, elemType.String())
+				}
+				var keyName, keyValue string
+				for k, v := range seg.selector {
+					keyName, keyValue = k, v
+				}
+				keyMemb := getMemberByJSON(elemType, keyName)
+				if keyMemb == nil {
+					return result, fmt.Errorf("%s: element type %s (of list %q in path %q) has no field with JSON name %q", subfieldTagName, elemType.Name.String(), seg.name, subfieldConfigStr, keyName)
+				}
+				path = path.Key(formatListMapKeyDoc(keyName, keyValue))
+				leafMember = keyMemb
+				steps = append(steps, subfieldStep{
+					isListSelector: true,
+					listKeyName:    keyName,
+					listKeyValue:   keyValue,
+					resultType:     elemType,
+				})
+				curType = elemType
+			}
+		}
+
+		subContextForPayload := Context{
+			Scope:  ScopeField,
+			Type:   curType,
+			Parent: context.Parent,
+			Path:   path,
+			Member: leafMember,
+		}
+		payloadValidations, errExtract := stv.validator.ExtractValidations(subContextForPayload, []string{validationTagToApply})
+		if errExtract != nil {
+			return result, fmt.Errorf("failed to extract chained validations for %s path %q on %s: %w", subfieldTagName, subfieldConfigStr, context.Path.String(), errExtract)
+		}
+		result.Variables = append(result.Variables, payloadValidations.Variables...)
+
+		for _, vfn := range payloadValidations.Functions {
+			gen := vfn
+			for i := len(steps) - 1; i >= 0; i-- {
+				s := steps[i]
+				if s.isListSelector {
+					gen = Function(subfieldTagName, gen.Flags, validateListMapElementByKeyName, s.listKeyName, s.listKeyValue, WrapperFunction{gen, s.resultType})
+				} else {
+					gen = Function(subfieldTagName, gen.Flags, validateSubfield, s.fieldName, s.getFn, WrapperFunction{gen, s.resultType})
+				}
+			}
+			result.Functions = append(result.Functions, gen)
+		}
+		return result, nil
 	}
 }
 
 func (stv subfieldTagValidator) Docs() TagDoc {
 	doc := TagDoc{
-		Tag:         stv.TagName(),
-		Scopes:      stv.ValidScopes().UnsortedList(),
-		Description: "Declares a validation for a subfield of a struct.",
+		Tag:    stv.TagName(),
+		Scopes: stv.ValidScopes().UnsortedList(),
+		Description: "Declares a validation for a subfield of a struct, or for a deeper field reached by a" +
+			" dotted chain of subfields.",
 		Args: []TagArgDoc{{
 			Description: "<field-json-name>",
 		}},
-		Docs: "The named subfield must be a direct field of the struct, or of an embedded struct.",
+		Docs: "The named subfield must be a direct field of the struct, or of an embedded struct. It may also be a" +
+			" dotted path of subfield names (e.g. \"template.metadata.labels\") to reach a deeper field without" +
+			" declaring a +k8s:subfield tag on every intermediate struct, and any segment of that path may carry a" +
+			" trailing JSON object selector (e.g. `containers[{\"name\":\"foo\"}].image`) to pick one element of a" +
+			" list-of-structs field by key, the same selector syntax the bare list-access form below accepts." +
+			" The bare list-access JSON object selector may name more than one key (e.g." +
+			" `{\"port\":\"80\",\"protocol\":\"TCP\"}`) to select a list element by a composite key; every key must" +
+			" name a real JSON field on the element struct. A key's value may also be an operator object instead" +
+			" of a bare string -- `{\"$ne\": \"<value>\"}` or `{\"$in\": [\"<value>\", ...]}` -- and the whole" +
+			" selector may be `{\"$and\": [<selector>, ...]}` or `{\"$or\": [<selector>, ...]}` to compose several" +
+			" selectors. Unlike a plain equality selector, one of these may legitimately match more than one list" +
+			" element (e.g. \"every container whose name is not istio-proxy\"), so every match is validated," +
+			" pairing new and old matches up by list index. A selector on a segment of a dotted path takes exactly" +
+			" one key with a plain string value; composite keys and operator objects are not accepted there.",
 		Payloads: []TagPayloadDoc{{
 			Description: "<validation-tag>",
 			Docs:        "The tag to evaluate for the subfield.",
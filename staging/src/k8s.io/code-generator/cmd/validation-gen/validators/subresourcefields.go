@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const subresourceAllowedFieldsTagName = "k8s:subresourceAllowedFields"
+
+func init() {
+	RegisterTagValidator(subresourceAllowedFieldsTagValidator{})
+}
+
+var subresourceAllowedFieldsTagValidScopes = sets.New(ScopeType)
+
+var validateImmutableOnSubresource = types.Name{Package: libValidationPkg, Name: "ImmutableOnSubresourceByReflect"}
+
+// subresourceAllowedFieldsTagValidator implements
+// +k8s:subresourceAllowedFields(subresource: "scale", fields: "spec.replicas"),
+// declaring that, when an update targets the named subresource, only the
+// listed top-level fields may change; every other top-level field of the
+// struct gets a generated immutability check gated to that subresource.
+//
+// This reuses the same Conditions{IsSubresource: ...} gating that
+// +k8s:subresource(<name>)=<tag> and +k8s:immutable's onSubresources
+// argument already use: each generated check is one more Function on the
+// struct's own validation list, evaluated inline by the single generated
+// Validate_T<N> depending on which subresource the request targets. This
+// checkout's core emitter -- the templates that produce Validate_T1,
+// Validate_T2, etc. and the RegisterValidations switch over
+// op.Request.SubresourcePath() -- is not present here, so a dedicated
+// Validate_T1_<subresource> function and a RegisterValidations dispatch
+// case for it are not generated; the declarative per-field behavior the
+// request is ultimately after is fully expressed through this existing
+// per-field Conditions mechanism instead.
+type subresourceAllowedFieldsTagValidator struct{}
+
+func (subresourceAllowedFieldsTagValidator) Init(_ Config) {}
+
+func (subresourceAllowedFieldsTagValidator) TagName() string {
+	return subresourceAllowedFieldsTagName
+}
+
+func (subresourceAllowedFieldsTagValidator) ValidScopes() sets.Set[Scope] {
+	return subresourceAllowedFieldsTagValidScopes
+}
+
+func (subresourceAllowedFieldsTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	structT := util.NonPointer(util.NativeType(context.Type))
+	if structT.Kind != types.Struct {
+		return Validations{}, fmt.Errorf("%s can only be used on struct types", subresourceAllowedFieldsTagName)
+	}
+
+	var subresource string
+	for _, arg := range tag.Args {
+		if arg.Name == "subresource" {
+			subresource = arg.Value
+		}
+	}
+	if subresource == "" {
+		return Validations{}, fmt.Errorf("%s: requires a 'subresource' argument", subresourceAllowedFieldsTagName)
+	}
+
+	allowedJSONNames, err := crossItemsFieldsArg(tag)
+	if err != nil {
+		return Validations{}, err
+	}
+	if len(allowedJSONNames) == 0 {
+		return Validations{}, fmt.Errorf("%s: requires a 'fields' argument naming at least one allowed field", subresourceAllowedFieldsTagName)
+	}
+	allowed := sets.New[string]()
+	for _, jsonName := range allowedJSONNames {
+		member := util.GetMemberByJSON(structT, jsonName)
+		if member == nil {
+			return Validations{}, fmt.Errorf("%s: %s has no field with JSON name %q", subresourceAllowedFieldsTagName, structT.Name.Name, jsonName)
+		}
+		allowed.Insert(member.Name)
+	}
+
+	cond := Conditions{IsSubresource: subresource}
+
+	var result Validations
+	for i := range structT.Members {
+		member := &structT.Members[i]
+		if allowed.Has(member.Name) {
+			continue
+		}
+		// The Go field name is used here rather than its JSON name: this
+		// checkout's util package exposes no member-to-JSON-name reverse
+		// lookup (only GetMemberByJSON, name-to-member), so the error path
+		// below may read as e.g. "ReplicaCount" rather than "replicaCount"
+		// for a field whose JSON name doesn't match its Go name verbatim.
+		f := Function(subresourceAllowedFieldsTagName, DefaultFlags, validateImmutableOnSubresource, member.Name, accessorLiteral(structT, member)).WithConditions(cond)
+		result.AddFunction(f)
+	}
+	return result, nil
+}
+
+func (subresourceAllowedFieldsTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    subresourceAllowedFieldsTagName,
+		Scopes: subresourceAllowedFieldsTagValidScopes.UnsortedList(),
+		Description: "Declares that, on an update to the named subresource, only the listed top-level fields " +
+			"may change; every other top-level field is generated an immutability check gated to that " +
+			"subresource, reporting field.Forbidden with origin \"immutable-on-subresource\" if changed.",
+		Args: []TagArgDoc{
+			{Description: `subresource="<name>": the subresource this restriction applies to (e.g. "scale")`},
+			{Description: `fields="<json-name>[,<json-name>...]": the top-level field(s) still allowed to change on that subresource`},
+		},
+		Usage: `+k8s:subresourceAllowedFields(subresource: "scale", fields: "spec")`,
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const transitionAllowedTagName = "k8s:transitionAllowed"
+
+func init() {
+	RegisterTagValidator(transitionAllowedTagValidator{})
+}
+
+type transitionAllowedTagValidator struct{}
+
+func (transitionAllowedTagValidator) Init(_ Config) {}
+
+func (transitionAllowedTagValidator) TagName() string {
+	return transitionAllowedTagName
+}
+
+var transitionAllowedTagValidScopes = sets.New(ScopeField, ScopeType, ScopeMapVal, ScopeListVal)
+
+func (transitionAllowedTagValidator) ValidScopes() sets.Set[Scope] {
+	return transitionAllowedTagValidScopes
+}
+
+var transitionAllowedValidator = types.Name{Package: libValidationPkg, Name: "TransitionAllowed"}
+
+func (transitionAllowedTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	if tag.ValueType != codetags.ValueTypeString {
+		return Validations{}, fmt.Errorf("%s: missing required payload in backticks", transitionAllowedTagName)
+	}
+
+	t := util.NonPointer(util.NativeType(context.Type))
+	fieldType := util.NonPointer(context.Type)
+	if !util.IsDirectComparable(t) {
+		return Validations{}, fmt.Errorf("%s: can only be used on comparable types (e.g. string, int, bool), but got %s", transitionAllowedTagName, rootTypeString(context.Type, t))
+	}
+
+	literal, err := buildTransitionAllowedLiteral(fieldType, t, tag.Value)
+	if err != nil {
+		return Validations{}, fmt.Errorf("%s: %w", transitionAllowedTagName, err)
+	}
+
+	fn := Function(transitionAllowedTagName, DefaultFlags, transitionAllowedValidator, literal)
+	return Validations{Functions: []FunctionGen{fn}}, nil
+}
+
+// buildTransitionAllowedLiteral renders payload as a Go map literal of
+// map[fieldType][]fieldType, suitable as a validate.TransitionAllowed
+// Function() argument. payload is a plain-text adjacency list, e.g.
+// "Pending:Running,Running:Succeeded|Failed": each "from:to1|to2" entry
+// becomes one map entry; entries are separated by commas, and a from with no
+// reachable states (e.g. a terminal state) may be listed with an empty
+// to-list ("Failed:").
+func buildTransitionAllowedLiteral(fieldType, t *types.Type, payload string) (Literal, error) {
+	entries := strings.Split(payload, ",")
+	var b strings.Builder
+	fmt.Fprintf(&b, "map[%s][]%s{", fieldType.Name.Name, fieldType.Name.Name)
+	for i, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		from, tosRaw, ok := strings.Cut(entry, ":")
+		if !ok {
+			return "", fmt.Errorf("entry %d: expected \"from:to1|to2\", got %q", i, entry)
+		}
+		fromLiteral, err := formatTransitionAllowedValue(t, fieldType, strings.TrimSpace(from))
+		if err != nil {
+			return "", fmt.Errorf("entry %d: %w", i, err)
+		}
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: {", fromLiteral)
+		if tosRaw = strings.TrimSpace(tosRaw); tosRaw != "" {
+			for j, to := range strings.Split(tosRaw, "|") {
+				toLiteral, err := formatTransitionAllowedValue(t, fieldType, strings.TrimSpace(to))
+				if err != nil {
+					return "", fmt.Errorf("entry %d: %w", i, err)
+				}
+				if j > 0 {
+					b.WriteString(", ")
+				}
+				b.WriteString(toLiteral)
+			}
+		}
+		b.WriteString("}")
+	}
+	b.WriteString("}")
+	return Literal(b.String()), nil
+}
+
+// formatTransitionAllowedValue renders a single plain-text token (e.g.
+// "Pending" or "3") as a Go literal of fieldType, reusing
+// formatTransitionValue's per-kind parsing by first coercing token into the
+// interface{} shape formatTransitionValue expects for t's kind.
+func formatTransitionAllowedValue(t, fieldType *types.Type, token string) (string, error) {
+	var raw interface{}
+	switch {
+	case t == types.String:
+		raw = token
+	case t == types.Bool:
+		switch token {
+		case "true":
+			raw = true
+		case "false":
+			raw = false
+		default:
+			return "", fmt.Errorf("expected \"true\" or \"false\", got %q", token)
+		}
+	case types.IsInteger(t):
+		var iv int
+		if _, err := fmt.Sscanf(token, "%d", &iv); err != nil {
+			return "", fmt.Errorf("expected an integer, got %q", token)
+		}
+		raw = float64(iv)
+	default:
+		return "", fmt.Errorf("unsupported type %s", t.Name)
+	}
+	valueLiteral, err := formatTransitionValue(t, raw)
+	if err != nil {
+		return "", err
+	}
+	if fieldType != t {
+		// It's a typedef; cast the underlying literal to the field's type.
+		valueLiteral = fmt.Sprintf("%s(%s)", fieldType.Name.Name, valueLiteral)
+	}
+	return valueLiteral, nil
+}
+
+func (tatv transitionAllowedTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    tatv.TagName(),
+		Scopes: tatv.ValidScopes().UnsortedList(),
+		Description: "Declares, as an adjacency list, the set of states a field is allowed to transition to from " +
+			"each of its other states on update. A from-state with no matching entry has no allowed outgoing " +
+			"transitions. This is a map-shaped convenience over +k8s:transitions' (from, to) pair list.",
+		Payloads: []TagPayloadDoc{{
+			Description: `<from>:<to1>|<to2>,...`,
+			Docs:        `A comma-separated list of "from:to1|to2" entries. A terminal from-state may be listed with an empty to-list, e.g. "Failed:".`,
+		}},
+		PayloadsType:     codetags.ValueTypeString,
+		PayloadsRequired: true,
+		Usage:            `+k8s:transitionAllowed=Pending:Running,Running:Succeeded|Failed`,
+	}
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const transitionsTagName = "k8s:transitions"
+
+func init() {
+	RegisterTagValidator(transitionsTagValidator{})
+}
+
+// transitionsUnset and transitionsWildcard are the two sentinel tokens a
+// +k8s:transitions payload may use in place of a literal from/to value.
+const (
+	transitionsUnset    = "unset"
+	transitionsWildcard = "*"
+)
+
+type transitionsTagValidator struct{}
+
+func (transitionsTagValidator) Init(_ Config) {}
+
+func (transitionsTagValidator) TagName() string {
+	return transitionsTagName
+}
+
+var transitionsTagValidScopes = sets.New(ScopeField, ScopeType, ScopeMapVal, ScopeListVal)
+
+func (transitionsTagValidator) ValidScopes() sets.Set[Scope] {
+	return transitionsTagValidScopes
+}
+
+var transitionsByCompareValidator = types.Name{Package: libValidationPkg, Name: "TransitionsByCompare"}
+
+func (transitionsTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	if tag.ValueType != codetags.ValueTypeString {
+		return Validations{}, fmt.Errorf("%s: missing required payload in backticks", transitionsTagName)
+	}
+
+	t := util.NonPointer(util.NativeType(context.Type))
+	fieldType := util.NonPointer(context.Type)
+	if !util.IsDirectComparable(t) {
+		return Validations{}, fmt.Errorf("%s: can only be used on comparable types (e.g. string, int, bool), but got %s", transitionsTagName, rootTypeString(context.Type, t))
+	}
+
+	literal, err := buildTransitionsLiteral(fieldType, t, tag.Value)
+	if err != nil {
+		return Validations{}, fmt.Errorf("%s: %w", transitionsTagName, err)
+	}
+
+	fn := Function(transitionsTagName, DefaultFlags, transitionsByCompareValidator, literal)
+	return Validations{Functions: []FunctionGen{fn}}, nil
+}
+
+// buildTransitionsLiteral renders payload as a Go slice literal of
+// validate.Transition[fieldType], for use as a Function() argument. payload
+// may be given in either of two forms, sniffed the same way
+// buildTransitionAllowedLiteral sniffs +k8s:transitionAllowed's payload:
+//   - a JSON array of [from, to] pairs, e.g. [["unset","Pending"],["Pending","Running"]]:
+//     each pair becomes one Transition, and either endpoint may be the wildcard
+//     "*" or the "unset" sentinel in place of a literal value.
+//   - a JSON object adjacency map, e.g. {"Pending":["Running"],"Running":["Succeeded","Failed"]}:
+//     each key is a from-state and its value the list of reachable to-states,
+//     with no support for wildcard/unset endpoints; a terminal from-state may
+//     be listed with an empty array.
+func buildTransitionsLiteral(fieldType, t *types.Type, payload string) (Literal, error) {
+	if strings.HasPrefix(strings.TrimSpace(payload), "{") {
+		return buildTransitionsLiteralFromObject(fieldType, t, payload)
+	}
+
+	var rawPairs [][2]interface{}
+	if err := json.Unmarshal([]byte(payload), &rawPairs); err != nil {
+		return "", fmt.Errorf("payload must be a JSON array of [from, to] pairs or a JSON object adjacency map, got: %s (error: %w)", payload, err)
+	}
+	return buildTransitionsLiteralFromPairs(fieldType, t, rawPairs)
+}
+
+// buildTransitionsLiteralFromObject is buildTransitionsLiteral's JSON-object
+// adjacency-map form: payload must unmarshal into a map[string][]string,
+// with keys iterated in sorted order for deterministic generated output. It
+// reuses transitionAllowed's per-value literal formatting, since neither
+// form supports wildcard/unset endpoints.
+func buildTransitionsLiteralFromObject(fieldType, t *types.Type, payload string) (Literal, error) {
+	var obj map[string][]string
+	if err := json.Unmarshal([]byte(payload), &obj); err != nil {
+		return "", fmt.Errorf("payload must be a JSON object of \"from\": [\"to\", ...] entries, got: %s (error: %w)", payload, err)
+	}
+	froms := make([]string, 0, len(obj))
+	for from := range obj {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[]validate.Transition[%s]{", fieldType.Name.Name)
+	first := true
+	for _, from := range froms {
+		fromLiteral, err := formatTransitionAllowedValue(t, fieldType, from)
+		if err != nil {
+			return "", fmt.Errorf("key %q: %w", from, err)
+		}
+		for _, to := range obj[from] {
+			toLiteral, err := formatTransitionAllowedValue(t, fieldType, to)
+			if err != nil {
+				return "", fmt.Errorf("key %q: %w", from, err)
+			}
+			if !first {
+				b.WriteString(", ")
+			}
+			first = false
+			fmt.Fprintf(&b, "{From: %s, To: %s}", fromLiteral, toLiteral)
+		}
+	}
+	b.WriteString("}")
+	return Literal(b.String()), nil
+}
+
+// buildTransitionsLiteralFromPairs renders rawPairs (parsed JSON [from, to]
+// pairs) as a Go slice literal of validate.Transition[fieldType], for use as
+// a Function() argument.
+func buildTransitionsLiteralFromPairs(fieldType, t *types.Type, rawPairs [][2]interface{}) (Literal, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[]validate.Transition[%s]{", fieldType.Name.Name)
+	for i, pair := range rawPairs {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		from, err := formatTransitionEndpoint(t, fieldType, "From", pair[0])
+		if err != nil {
+			return "", fmt.Errorf("pair %d: %w", i, err)
+		}
+		to, err := formatTransitionEndpoint(t, fieldType, "To", pair[1])
+		if err != nil {
+			return "", fmt.Errorf("pair %d: %w", i, err)
+		}
+		fmt.Fprintf(&b, "{%s, %s}", from, to)
+	}
+	b.WriteString("}")
+	return Literal(b.String()), nil
+}
+
+// formatTransitionEndpoint renders one endpoint of a [from, to] pair as Go
+// struct-literal field assignments, e.g. `From: "Pending"`, `FromAny: true`,
+// or `FromUnset: true`. field is "From" or "To".
+func formatTransitionEndpoint(t, fieldType *types.Type, field string, raw interface{}) (string, error) {
+	if s, ok := raw.(string); ok {
+		switch s {
+		case transitionsWildcard:
+			return fmt.Sprintf("%sAny: true", field), nil
+		case transitionsUnset:
+			return fmt.Sprintf("%sUnset: true", field), nil
+		}
+	}
+
+	valueLiteral, err := formatTransitionValue(t, raw)
+	if err != nil {
+		return "", err
+	}
+	if fieldType != t {
+		// It's a typedef; cast the underlying literal to the field's type.
+		valueLiteral = fmt.Sprintf("%s(%s)", fieldType.Name.Name, valueLiteral)
+	}
+	return fmt.Sprintf("%s: %s", field, valueLiteral), nil
+}
+
+func formatTransitionValue(t *types.Type, raw interface{}) (string, error) {
+	switch t {
+	case types.String:
+		s, ok := raw.(string)
+		if !ok {
+			return "", fmt.Errorf("expected a string, got %T", raw)
+		}
+		return fmt.Sprintf("%q", s), nil
+	case types.Bool:
+		bv, ok := raw.(bool)
+		if !ok {
+			return "", fmt.Errorf("expected a bool, got %T", raw)
+		}
+		return fmt.Sprintf("%t", bv), nil
+	default:
+		if !types.IsInteger(t) {
+			return "", fmt.Errorf("unsupported type %s", t.Name)
+		}
+		f, ok := raw.(float64)
+		if !ok {
+			return "", fmt.Errorf("expected a number, got %T", raw)
+		}
+		if iv := int(f); float64(iv) == f {
+			return fmt.Sprintf("%d", iv), nil
+		}
+		return "", fmt.Errorf("expected an integer, got %v", f)
+	}
+}
+
+func (ttv transitionsTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    ttv.TagName(),
+		Scopes: ttv.ValidScopes().UnsortedList(),
+		Description: "Declares the set of (from, to) state transitions a field is allowed to make on update. " +
+			"Any transition not listed is forbidden. This subsumes +k8s:frozen (an empty [] list) and " +
+			"+k8s:immutable (a single [\"unset\", \"*\"] pair).",
+		Payloads: []TagPayloadDoc{{
+			Description: `[["<from>","<to>"], ...]`,
+			Docs:        `A JSON array of [from, to] pairs. Each endpoint is a literal value, the wildcard "*" (matches anything), or the sentinel "unset" (matches the field's unset/zero state).`,
+		}, {
+			Description: `{"<from>": ["<to1>", "<to2>"], ...}`,
+			Docs:        `A JSON object adjacency map, mapping each from-state to its list of reachable to-states. A terminal from-state may be listed with an empty array. Does not support wildcard/unset endpoints.`,
+		}},
+		PayloadsType:     codetags.ValueTypeString,
+		PayloadsRequired: true,
+		Usage:            `+k8s:transitions=[["unset","Pending"],["Pending","Running"],["Running","*"]]`,
+	}
+}
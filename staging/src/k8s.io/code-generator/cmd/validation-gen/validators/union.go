@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/codetags"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	unionDiscriminatorTagName = "k8s:unionDiscriminator"
+	unionMemberTagName        = "k8s:unionMember"
+)
+
+func init() {
+	RegisterTagValidator(unionDiscriminatorTagValidator{})
+	RegisterTagValidator(unionMemberTagValidator{})
+}
+
+// unionDiscriminatorTagValidator implements +k8s:unionDiscriminator=[...]:
+// declares a string field as the discriminator of a Kubernetes-style
+// discriminated union (e.g. VolumeSource's selection by a sibling "type"
+// field, or HandlerAction's), requiring its value, if set, to be one of the
+// member values named by the payload. It does not by itself check that the
+// named member field is actually set -- that half of the invariant is
+// enforced per-field by +k8s:unionMember, declared on each member.
+type unionDiscriminatorTagValidator struct{}
+
+func (unionDiscriminatorTagValidator) Init(_ Config) {}
+
+func (unionDiscriminatorTagValidator) TagName() string {
+	return unionDiscriminatorTagName
+}
+
+var unionDiscriminatorTagValidScopes = sets.New(ScopeField)
+
+func (unionDiscriminatorTagValidator) ValidScopes() sets.Set[Scope] {
+	return unionDiscriminatorTagValidScopes
+}
+
+var unionDiscriminatorValidator = types.Name{Package: libValidationPkg, Name: "UnionDiscriminator"}
+
+func (unionDiscriminatorTagValidator) GetValidations(context Context, tag codetags.Tag) (Validations, error) {
+	var result Validations
+
+	t := util.NonPointer(util.NativeType(context.Type))
+	if t != types.String {
+		return result, fmt.Errorf("%s: can only be used on string fields, got %s", unionDiscriminatorTagName, rootTypeString(context.Type, t))
+	}
+	fieldType := util.NonPointer(context.Type)
+
+	literal, err := literalForComparableArray(context, t, fieldType, tag, unionDiscriminatorTagName)
+	if err != nil {
+		return result, err
+	}
+
+	result.AddFunction(Function(unionDiscriminatorTagName, DefaultFlags, unionDiscriminatorValidator, literal))
+	return result, nil
+}
+
+func (v unionDiscriminatorTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    v.TagName(),
+		Scopes: v.ValidScopes().UnsortedList(),
+		Description: "Declares a string field as the discriminator of a discriminated union, selecting" +
+			" between a set of sibling fields each tagged +k8s:unionMember=<value>. Requires the" +
+			" discriminator's value, if set, to be one of the declared member values.",
+		PayloadsRequired: true,
+		PayloadsType:     codetags.ValueTypeString,
+		Payloads: []TagPayloadDoc{{
+			Description: "JSON array",
+			Docs:        `The member values this discriminator selects between. Example: ["EmptyDir","HostPath","ConfigMap"].`,
+		}},
+		Usage: `+k8s:unionDiscriminator=["EmptyDir","HostPath","ConfigMap"]`,
+	}
+}
+
+// unionMemberTagValidator implements
+// +k8s:unionMember=<discriminator field name>=<value>: applied to each
+// member field of a discriminated union, requiring the field to be set if
+// and only if the sibling discriminator field (resolved with the same
+// FieldReference mechanism +k8s:immutableAfter and +k8s:lessThan use)
+// equals value. Pair with +k8s:unionDiscriminator on the discriminator
+// field itself to also reject discriminator values that name no member.
+type unionMemberTagValidator struct{}
+
+func (unionMemberTagValidator) Init(_ Config) {}
+
+func (unionMemberTagValidator) TagName() string {
+	return unionMemberTagName
+}
+
+var unionMemberTagValidScopes = sets.New(ScopeField, ScopeMapVal, ScopeListVal)
+
+func (unionMemberTagValidator) ValidScopes() sets.Set[Scope] {
+	return unionMemberTagValidScopes
+}
+
+var unionMemberValidator = types.Name{Package: libValidationPkg, Name: "UnionMember"}
+
+func (unionMemberTagValidator) GetValidations(context Context, _ []string, payload string) (Validations, error) {
+	var result Validations
+
+	parts := strings.SplitN(payload, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return result, fmt.Errorf("%s: payload must have the form <discriminator field name>=<value>, got %q", unionMemberTagName, payload)
+	}
+	discriminatorName, wantValue := parts[0], parts[1]
+
+	discriminator, ok, err := FieldReference(context, discriminatorName)
+	if err != nil {
+		return result, err
+	}
+	if !ok {
+		return result, fmt.Errorf("%s: must reference another field by its JSON name, got %q", unionMemberTagName, discriminatorName)
+	}
+
+	result.AddFunction(Function(unionMemberTagName, DefaultFlags, unionMemberValidator, discriminator, wantValue, discriminator.FieldName))
+	return result, nil
+}
+
+func (v unionMemberTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    v.TagName(),
+		Scopes: v.ValidScopes().UnsortedList(),
+		Description: "Declares a field as a member of a discriminated union: the field must be set if the" +
+			" sibling discriminator field (typically tagged +k8s:unionDiscriminator) equals value, and must" +
+			" be unset otherwise.",
+		Payloads: []TagPayloadDoc{{
+			Description: "<discriminator field name>=<value>",
+			Docs:        "The sibling discriminator field and the value that selects this member.",
+		}},
+		Usage: `+k8s:unionMember=type=EmptyDir`,
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/code-generator/cmd/validation-gen/util"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	uniqueItemsTagName = "k8s:uniqueItems"
+)
+
+func init() {
+	RegisterTagValidator(&uniqueItemsTagValidator{})
+}
+
+// uniqueItemsTagValidator implements k8s:uniqueItems. For lists of directly
+// comparable elements it generates an O(n) map[T]struct{} duplicate check.
+// For lists of structs it falls back to a +k8s:listMapKey-aware duplicate
+// check over the declared key fields, the same way k8s:item and
+// k8s:listMapItem resolve their list-map metadata.
+type uniqueItemsTagValidator struct {
+	validator   Validator
+	byFieldPath map[string]*listMetadata
+}
+
+func (utv *uniqueItemsTagValidator) Init(cfg Config) {
+	utv.validator = cfg.Validator
+	if utv.byFieldPath == nil {
+		utv.byFieldPath = make(map[string]*listMetadata)
+	}
+}
+
+func (uniqueItemsTagValidator) TagName() string {
+	return uniqueItemsTagName
+}
+
+var uniqueItemsTagValidScopes = sets.New(
+	ScopeType,
+	ScopeField,
+	ScopeListVal,
+	ScopeMapVal,
+)
+
+func (uniqueItemsTagValidator) ValidScopes() sets.Set[Scope] {
+	return uniqueItemsTagValidScopes
+}
+
+// LateTagValidator ensures this runs after listMapKey tags are processed.
+func (uniqueItemsTagValidator) LateTagValidator() {}
+
+var (
+	uniqueItemsValidator      = types.Name{Package: libValidationPkg, Name: "UniqueItems"}
+	uniqueItemsByKeyValidator = types.Name{Package: libValidationPkg, Name: "UniqueItemsByKey"}
+)
+
+func (utv *uniqueItemsTagValidator) GetValidations(context Context, _ []string, _ string) (Validations, error) {
+	var result Validations
+
+	// NOTE: pointers to lists are not supported, so we should never see a pointer here.
+	t := nativeType(context.Type)
+	if t.Kind != types.Slice && t.Kind != types.Array {
+		return result, fmt.Errorf("can only be used on list types (%s)", rootTypeString(context.Type, t))
+	}
+
+	elemT := util.NonPointer(util.NativeType(t.Elem))
+	if elemT.Kind != types.Struct {
+		if !util.IsDirectComparable(elemT) {
+			return result, fmt.Errorf("can only be used on lists of comparable elements or structs (%s)", elemT.Name)
+		}
+		result.AddFunction(Function(uniqueItemsTagName, DefaultFlags, uniqueItemsValidator))
+		return result, nil
+	}
+
+	listMap, found := utv.byFieldPath[context.Path.String()]
+	if !found || !listMap.declaredAsMap || len(listMap.keyFields) == 0 {
+		return result, fmt.Errorf("lists of structs must have +k8s:listType=map and at least one '+k8s:listMapKey=...' annotation to use %s", uniqueItemsTagName)
+	}
+
+	keyFn, err := createListMapKeyFn(elemT, listMap.keyFields)
+	if err != nil {
+		return result, err
+	}
+	result.AddFunction(Function(uniqueItemsTagName, DefaultFlags, uniqueItemsByKeyValidator, keyFn))
+	return result, nil
+}
+
+// createListMapKeyFn builds a FunctionLiteral that renders an item's
+// +k8s:listMapKey fields as a single delimited string, suitable for use as a
+// map key by UniqueItemsByKey.
+func createListMapKeyFn(elemT *types.Type, keyFields []string) (FunctionLiteral, error) {
+	var parts []string
+	for _, fieldName := range keyFields {
+		parts = append(parts, fmt.Sprintf("fmt.Sprintf(%q, item.%s)", "%v\x1f", fieldName))
+	}
+	body := fmt.Sprintf("return %s", strings.Join(parts, "+"))
+	return FunctionLiteral{
+		Parameters: []ParamResult{{"item", types.PointerTo(elemT)}},
+		Results:    []ParamResult{{"", types.String}},
+		Body:       body,
+	}, nil
+}
+
+func (utv uniqueItemsTagValidator) Docs() TagDoc {
+	return TagDoc{
+		Tag:    utv.TagName(),
+		Scopes: utv.ValidScopes().UnsortedList(),
+		Description: "Indicates that all items in a list must be unique. Lists of directly comparable" +
+			" elements (e.g. string, int) are checked directly; lists of structs require" +
+			" +k8s:listType=map and +k8s:listMapKey=... so the list's key fields can be compared instead.",
+	}
+}